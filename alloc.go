@@ -0,0 +1,82 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"fmt"
+	"runtime"
+
+	"github.com/lvan100/go-assert/internal"
+)
+
+// AllocsRuns is the number of runs of the function whose allocations are
+// averaged by MaxAllocsPerRun and AllocsInDelta.
+const AllocsRuns = 100
+
+// MaxAllocsPerRun asserts that fn allocates at most n times per run, on
+// average over AllocsRuns runs, measured as testing.AllocsPerRun does. It
+// guards performance-sensitive code against allocation regressions. As
+// allocations are counted for the whole process, it must not run in
+// parallel with other tests.
+func MaxAllocsPerRun(t internal.T, n float64, fn func(), msg ...interface{}) bool {
+	t.Helper()
+	defer track(t, nil)()
+	got := allocsPerRun(AllocsRuns, fn)
+	if got > n {
+		str := fmt.Sprintf(`too many allocations per run:
+    got: %v
+ expect: at most %v`, got, n)
+		failValues(t, got, n, str, msg...)
+		return false
+	}
+	return true
+}
+
+// AllocsInDelta asserts that fn allocates expect times per run, give or
+// take delta, on average over AllocsRuns runs, measured as
+// testing.AllocsPerRun does. Unlike MaxAllocsPerRun, it also catches a drop in
+// allocations, which calls for tightening the expectation.
+func AllocsInDelta(t internal.T, expect, delta float64, fn func(), msg ...interface{}) bool {
+	t.Helper()
+	defer track(t, nil)()
+	got := allocsPerRun(AllocsRuns, fn)
+	if got < expect-delta || got > expect+delta {
+		str := fmt.Sprintf(`allocations per run not within delta:
+    got: %v
+ expect: %v ± %v`, got, expect, delta)
+		failValues(t, got, expect, str, msg...)
+		return false
+	}
+	return true
+}
+
+// allocsPerRun returns the average number of allocations during calls to fn
+// over the given number of runs, after a warm-up run, as testing.AllocsPerRun
+// does. It is measured here so that this package, which also serves non-test
+// code through package check, does not import testing.
+func allocsPerRun(runs int, fn func()) float64 {
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(1))
+	fn()
+	var stats runtime.MemStats
+	runtime.ReadMemStats(&stats)
+	mallocs := stats.Mallocs
+	for range runs {
+		fn()
+	}
+	runtime.ReadMemStats(&stats)
+	return float64((stats.Mallocs - mallocs) / uint64(runs))
+}
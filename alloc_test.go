@@ -0,0 +1,57 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert_test
+
+import (
+	"testing"
+
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/internal/mock"
+)
+
+var allocSink []byte
+
+func allocOnce() {
+	allocSink = make([]byte, 64)
+}
+
+func TestMaxAllocsPerRun(t *testing.T) {
+	runCase(t, func(g *mock.MockT) {
+		assert.MaxAllocsPerRun(g, 0, func() {})
+		assert.MaxAllocsPerRun(g, 1, allocOnce)
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`too many allocations per run:
+    got: 1
+ expect: at most 0
+message: hot path`})
+		assert.MaxAllocsPerRun(g, 0, allocOnce, "hot path")
+	})
+}
+
+func TestAllocsInDelta(t *testing.T) {
+	runCase(t, func(g *mock.MockT) {
+		assert.AllocsInDelta(g, 1, 0, allocOnce)
+		assert.AllocsInDelta(g, 2, 1, allocOnce)
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`allocations per run not within delta:
+    got: 1
+ expect: 3 ± 1`})
+		assert.AllocsInDelta(g, 3, 1, allocOnce)
+	})
+}
@@ -0,0 +1,111 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"github.com/lvan100/go-assert/internal"
+)
+
+// ArtifactsEnv is the environment variable naming the artifacts directory
+// when Settings.ArtifactsDir is empty.
+const ArtifactsEnv = "TEST_ARTIFACTS"
+
+// artifactsDir returns the directory failure artifacts are written to, or
+// "" if they are disabled.
+func artifactsDir(s Settings) string {
+	if s.ArtifactsDir != "" {
+		return s.ArtifactsDir
+	}
+	return os.Getenv(ArtifactsEnv)
+}
+
+// artifactThreshold returns the size above which artifacts are written.
+func artifactThreshold(s Settings) int {
+	if s.ArtifactThreshold > 0 {
+		return s.ArtifactThreshold
+	}
+	return TruncatedValueLen
+}
+
+// writeArtifacts writes the full got and expect values of f, and a diff of
+// them, or else its full text, to a new directory under the artifacts
+// directory when they exceed the size threshold. It returns the directory,
+// or "" if nothing was written.
+func writeArtifacts(t internal.T, f *Failure) (string, error) {
+	s := currentSettings()
+	base := artifactsDir(s)
+	if base == "" {
+		return "", nil
+	}
+	limit := artifactThreshold(s)
+	files := make(map[string]string)
+	if f.Got != nil || f.Expect != nil {
+		got, expect := dumpValue(f.Got), dumpValue(f.Expect)
+		if len(got) <= limit && len(expect) <= limit {
+			return "", nil
+		}
+		files["got.txt"] = got
+		files["expect.txt"] = expect
+		if diffable(got, expect) {
+			files["diff.txt"] = lineDiff(expect, got)
+		}
+	} else {
+		if len(f.Text) <= limit {
+			return "", nil
+		}
+		files["failure.txt"] = f.Text + "\n"
+	}
+
+	name := t.Name()
+	if name == "" {
+		name = "test"
+	}
+	parent := filepath.Join(base, sanitizeName(name))
+	if err := os.MkdirAll(parent, 0755); err != nil {
+		return "", err
+	}
+	dir, err := os.MkdirTemp(parent, sanitizeName(f.Op)+"-")
+	if err != nil {
+		return "", err
+	}
+	for file, content := range files {
+		if err = os.WriteFile(filepath.Join(dir, file), []byte(content), 0644); err != nil {
+			return "", err
+		}
+	}
+	return dir, nil
+}
+
+// dumpValue renders v completely for an artifact file.
+func dumpValue(v interface{}) string {
+	if s, ok := v.(string); ok {
+		return s
+	}
+	return prettyFormat(v)
+}
+
+var unsafeNameChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// sanitizeName turns a test or operation name into a file name. Subtest
+// separators become directory-safe underscores.
+func sanitizeName(name string) string {
+	return unsafeNameChars.ReplaceAllString(name, "_")
+}
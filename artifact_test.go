@@ -0,0 +1,72 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert_test
+
+import (
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/lvan100/go-assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestArtifacts(t *testing.T) {
+	dir := t.TempDir()
+	defer assert.Configure(func(s *assert.Settings) {
+		s.ArtifactsDir = dir
+		s.ArtifactThreshold = 8
+	})()
+
+	files := regexp.MustCompile(`\n  files: (.*)$`)
+	t.Run("values", func(t *testing.T) {
+		runNamedCase(t, t.Name(), func(g *namedT) {
+			var out string
+			g.EXPECT().Error(gomock.Any()).Do(func(args ...interface{}) {
+				out = args[0].(string)
+			})
+			assert.ThatString(g, "line 1\nline 2\nline 3").Equal("line 1\nline 3")
+			m := files.FindStringSubmatch(out)
+			assert.NotNil(t, m, out)
+			artifacts := m[1]
+			assert.ThatString(t, filepath.Base(artifacts)).HasPrefix("StringAssertion.Equal-")
+			assert.ThatFile(t, filepath.Join(artifacts, "got.txt")).ContentEqual("line 1\nline 2\nline 3")
+			assert.ThatFile(t, filepath.Join(artifacts, "expect.txt")).ContentEqual("line 1\nline 3")
+			assert.ThatFile(t, filepath.Join(artifacts, "diff.txt")).ContentEqual("  line 1\n+ line 2\n  line 3")
+		})
+	})
+	t.Run("text", func(t *testing.T) {
+		runNamedCase(t, t.Name(), func(g *namedT) {
+			var out string
+			g.EXPECT().Error(gomock.Any()).Do(func(args ...interface{}) {
+				out = args[0].(string)
+			})
+			assert.ThatString(g, "a").HasPrefix("b")
+			m := files.FindStringSubmatch(out)
+			assert.NotNil(t, m, out)
+			assert.ThatFile(t, filepath.Join(m[1], "failure.txt")).ContentEqual(
+				strings.TrimSuffix(out, m[0]) + "\n")
+		})
+	})
+	t.Run("small", func(t *testing.T) {
+		runNamedCase(t, t.Name(), func(g *namedT) {
+			g.EXPECT().Error([]interface{}{"got (int) 1 but expect greater than (int) 2"})
+			assert.ThatNumber(g, 1).GreaterThan(2)
+		})
+	})
+}
@@ -21,34 +21,166 @@ package assert
 import (
 	"fmt"
 	"reflect"
-	"regexp"
 	"strings"
 
 	"github.com/lvan100/go-assert/internal"
 )
 
-func fail(t internal.T, str string, msg ...string) {
+// T is the test handler interface accepted by assertions and passed to
+// reporters. It is a subset of testing.TB, so any testing.TB, such as
+// *testing.T, *testing.B or *testing.F, implements it, as do Asserter and
+// Collector. Methods that not every handler can provide, such as Setenv,
+// are optional, looked up through the wrapped handlers.
+type T = internal.T
+
+// formatMessage returns the user message of a failing assertion. If the
+// first argument is a string with formatting verbs and others follow, it is
+// used as a format string for them, as by fmt.Sprintf; otherwise the
+// arguments are printed and joined with ", ".
+func formatMessage(msg []interface{}) string {
+	if len(msg) == 0 {
+		return ""
+	}
+	if format, ok := msg[0].(string); ok && len(msg) > 1 && hasVerb(format) {
+		return fmt.Sprintf(format, msg[1:]...)
+	}
+	parts := make([]string, len(msg))
+	for i, m := range msg {
+		parts[i] = fmt.Sprint(m)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// hasVerb reports whether s contains a formatting verb, a "%" followed by
+// anything but another "%".
+func hasVerb(s string) bool {
+	for i := 0; i < len(s)-1; i++ {
+		if s[i] == '%' {
+			if s[i+1] != '%' {
+				return true
+			}
+			i++
+		}
+	}
+	return false
+}
+
+func fail(t internal.T, str string, msg ...interface{}) {
+	t.Helper()
+	report(t, &Failure{Text: str}, msg...)
+}
+
+// failValues is like fail for assertions comparing the values got and expect,
+// which are made available to message templates.
+func failValues(t internal.T, got, expect interface{}, str string, msg ...interface{}) {
 	t.Helper()
+	report(t, &Failure{Text: str, Got: got, Expect: expect}, msg...)
+}
+
+func report(t internal.T, f *Failure, msg ...interface{}) {
+	t.Helper()
+	if skipped(t) {
+		return
+	}
+	// failures collected by Retry are only reported once, by Retry itself,
+	// and those collected by package check are returned as errors
+	_, collected := findT[internal.Collected](t)
+	collected = collected || swallows(t)
+	var reporters []Reporter
+	if !collected {
+		reporters = reportersOf(t)
+	}
+	f.Op = callerOp()
+	f.Message = formatMessage(msg)
+	f.Context = contextOf(t)
+	f.Phases = phasesOf(t)
+	f.Caller = callerLocation()
+	// the labeled lines are only parsed if a template or a reporter sees them
+	if _, ok := templateFor(f.Op); ok || len(reporters) > 0 {
+		f.Fields = parseFields(f.Text)
+	}
+	var (
+		dir         string
+		artifactErr error
+	)
+	if !collected {
+		dir, artifactErr = writeArtifacts(t, f)
+	}
+	f.Artifacts = dir
+
+	b := getBuffer()
+	defer putBuffer(b)
+	if f.Caller != "" && callerLocationOf(t) {
+		b.WriteString(f.Caller)
+		b.WriteString(": ")
+	}
+	for _, c := range f.Context {
+		b.WriteString(c)
+		b.WriteString(": ")
+	}
+	b.WriteString(applyTemplate(t, f))
 	if len(msg) > 0 {
-		str += "\nmessage: " + strings.Join(msg, ", ")
+		b.WriteString("\nmessage: ")
+		b.WriteString(f.Message)
+	}
+	if inputs := fuzzInputOf(t); len(inputs) > 0 {
+		b.WriteString(formatFuzzInput(inputs))
+	}
+	if artifactErr != nil {
+		b.WriteString("\n  files: unable to write artifacts: ")
+		b.WriteString(artifactErr.Error())
+	} else if dir != "" {
+		b.WriteString("\n  files: ")
+		b.WriteString(dir)
+	}
+	if stackTraceOf(t) {
+		b.WriteString("\n  stack:")
+		writeStackTrace(b)
+	}
+	str := b.String()
+	if len(f.Phases) > 0 {
+		str = indentPhases(f.Phases, str)
+	}
+	f.Output = str
+	if _, plain := findT[internal.Plain](t); !plain && colorEnabled(t) {
+		str = colorize(str)
+	}
+	if !collected {
+		countFailure(t)
+	}
+	for _, r := range reporters {
+		r.Report(t, *f)
+	}
+	if !collected {
+		runFailureHooks(t, *f)
+	}
+	if failFastOf(t) {
+		t.Fatal(str)
+		return
 	}
 	t.Error(str)
 }
 
 // True asserts that got is true. It reports an error if the value is false.
-func True(t internal.T, got bool, msg ...string) {
+func True(t internal.T, got bool, msg ...interface{}) bool {
 	t.Helper()
+	defer track(t, got)()
 	if !got {
-		fail(t, "got false but expect true", msg...)
+		failValues(t, got, true, "got false but expect true", msg...)
+		return false
 	}
+	return true
 }
 
 // False asserts that got is false. It reports an error if the value is true.
-func False(t internal.T, got bool, msg ...string) {
+func False(t internal.T, got bool, msg ...interface{}) bool {
 	t.Helper()
+	defer track(t, got)()
 	if got {
-		fail(t, "got true but expect false", msg...)
+		failValues(t, got, false, "got true but expect false", msg...)
+		return false
 	}
+	return true
 }
 
 func isNil(v reflect.Value) bool {
@@ -67,46 +199,56 @@ func isNil(v reflect.Value) bool {
 }
 
 // Nil asserts that got is nil. It reports an error if the value is not nil.
-func Nil(t internal.T, got interface{}, msg ...string) {
+func Nil(t internal.T, got interface{}, msg ...interface{}) bool {
 	t.Helper()
+	defer track(t, got)()
 	// Why can't we use got==nil to judge？Because if
 	// a := (*int)(nil)        // %T == *int
 	// b := (interface{})(nil) // %T == <nil>
 	// then a==b is false, because they are different types.
 	if !isNil(reflect.ValueOf(got)) {
-		str := fmt.Sprintf("got (%T) %v but expect nil", got, got)
+		str := fmt.Sprintf("got (%T) %v but expect nil", got, show(t, got))
 		fail(t, str, msg...)
+		return false
 	}
+	return true
 }
 
 // NotNil asserts that got is not nil. It reports an error if the value is nil.
-func NotNil(t internal.T, got interface{}, msg ...string) {
+func NotNil(t internal.T, got interface{}, msg ...interface{}) bool {
 	t.Helper()
+	defer track(t, got)()
 	if isNil(reflect.ValueOf(got)) {
 		fail(t, "got nil but expect not nil", msg...)
+		return false
 	}
+	return true
 }
 
 // Panic asserts that fn panics and the panic message matches expr.
 // It reports an error if fn does not panic or if the recovered message does not satisfy expr.
-func Panic(t internal.T, fn func(), expr string, msg ...string) {
+func Panic(t internal.T, fn func(), expr string, msg ...interface{}) bool {
 	t.Helper()
+	defer track(t, nil)()
 	str := recovery(fn)
 	if str == "<<SUCCESS>>" {
 		fail(t, "did not panic", msg...)
-	} else {
-		matches(t, str, expr, msg...)
+		return false
 	}
+	return matches(t, str, expr, msg...)
 }
 
-func matches(t internal.T, got string, expr string, msg ...string) {
+func matches(t internal.T, got string, expr string, msg ...interface{}) bool {
 	t.Helper()
-	if ok, err := regexp.MatchString(expr, got); err != nil {
+	if ok, err := matchString(expr, got); err != nil {
 		fail(t, "invalid pattern", msg...)
+		return false
 	} else if !ok {
-		str := fmt.Sprintf("got %q which does not match %q", got, expr)
+		str := fmt.Sprintf("got %q which does not match %q", show(t, got), expr)
 		fail(t, str, msg...)
+		return false
 	}
+	return true
 }
 
 func recovery(fn func()) (str string) {
@@ -135,49 +277,186 @@ func That(t internal.T, v interface{}) *ThatAssertion {
 
 // Equal asserts that the wrapped value v is deeply equal to expect.
 // It reports an error if the values are not deeply equal.
-func (a *ThatAssertion) Equal(expect interface{}, msg ...string) {
+func (a *ThatAssertion) Equal(expect interface{}, msg ...interface{}) (ok bool) {
 	a.t.Helper()
-	if !reflect.DeepEqual(a.v, expect) {
-		str := fmt.Sprintf("got (%T) %v but expect (%T) %v", a.v, a.v, expect, expect)
+	defer trackResult(a.t, a.v, &ok)()
+	return equal(a.t, a.v, expect, msg...)
+}
+
+// equal implements Equal.
+func equal(t internal.T, got, expect interface{}, msg ...interface{}) bool {
+	t.Helper()
+	equal, ok := equalValues(t, got, expect, msg...)
+	if !ok {
+		return false
+	}
+	if !equal {
+		str := fmt.Sprintf("got (%T) %v but expect (%T) %v", got, show(t, got), expect, show(t, expect))
+		if d, ok := structuredDiff(t, got, expect); ok {
+			str = fmt.Sprintf("values not equal:\n   type: (%T)\n%s", got, d)
+		}
+		failValues(t, got, expect, str, msg...)
+		return false
+	}
+	return true
+}
+
+// structuredDiff returns the line diff of the pretty-printed forms of got
+// and expect if they have the same type and render on several lines, as
+// structs, slices and maps with elements do, so that differing fields and
+// elements are shown instead of both whole values. VerbosityFull asks for
+// the whole values and gets no diff, as do values too long to be diffed.
+func structuredDiff(t internal.T, got, expect interface{}) (string, bool) {
+	if verbosityOf(t) == VerbosityFull {
+		return "", false
+	}
+	if got == nil || expect == nil || reflect.TypeOf(got) != reflect.TypeOf(expect) {
+		return "", false
+	}
+	g, e := prettyFormat(got), prettyFormat(expect)
+	if !isMultiline(g, e) || !diffable(g, e) {
+		return "", false
+	}
+	return unifiedDiff(e, g), true
+}
+
+// IgnoredFields holds the struct field paths skipped by EqualIgnoring.
+type IgnoredFields []string
+
+// IgnoringFields returns the struct field paths skipped by EqualIgnoring,
+// such as generated IDs and timestamps. A path is a dotted chain of field
+// names, e.g. "Meta.CreatedAt", and applies to every element when it passes
+// through slices and maps.
+func IgnoringFields(paths ...string) IgnoredFields {
+	return paths
+}
+
+// EqualIgnoring asserts that the wrapped value v is deeply equal to expect
+// while skipping the given struct field paths, see IgnoringFields:
+//
+//	assert.That(t, got).EqualIgnoring(expect, assert.IgnoringFields("ID", "Items.CreatedAt"))
+//
+// It reports an error naming the first differing path otherwise.
+func (a *ThatAssertion) EqualIgnoring(expect interface{}, fields IgnoredFields, msg ...interface{}) (ok bool) {
+	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
+	if d := newDeepCompare(fields...).compare(a.v, expect); d != nil {
+		str := fmt.Sprintf(`values not equal ignoring %q:
+%s`, []string(fields), d)
+		fail(a.t, str, msg...)
+		return false
+	}
+	return true
+}
+
+// EqualGraph asserts that the wrapped value v is deeply equal to expect and
+// that both object graphs share pointers in the same way: whenever two
+// pointers or maps in v refer to the same object, the corresponding ones in
+// expect must do so too, and vice versa. It reports an error naming the
+// first differing path otherwise.
+func (a *ThatAssertion) EqualGraph(expect interface{}, msg ...interface{}) (ok bool) {
+	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
+	if d := newDeepCompare().withAliasing().compare(a.v, expect); d != nil {
+		str := fmt.Sprintf(`object graphs not equal:
+%s`, d)
 		fail(a.t, str, msg...)
+		return false
 	}
+	return true
+}
+
+// EqualExported asserts that the wrapped value v is deeply equal to expect,
+// comparing exported struct fields only, so that values holding a
+// sync.Mutex or other unexported state can be compared. It reports an error
+// naming the first differing path otherwise.
+func (a *ThatAssertion) EqualExported(expect interface{}, msg ...interface{}) (ok bool) {
+	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
+	c := newDeepCompare()
+	c.exported = true
+	if d := c.compare(a.v, expect); d != nil {
+		str := fmt.Sprintf(`exported fields not equal:
+%s`, d)
+		fail(a.t, str, msg...)
+		return false
+	}
+	return true
+}
+
+// DiffReport asserts that the wrapped value v is deeply equal to expect,
+// comparing exported struct fields only. Unlike Equal, it does not stop at
+// the first mismatch: the error lists every differing field path in a table
+// with got and expect columns, which suits large domain objects.
+func (a *ThatAssertion) DiffReport(expect interface{}, msg ...interface{}) (ok bool) {
+	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
+	c := newDeepCompare()
+	c.exported = true
+	if diffs := c.compareAll(a.v, expect); len(diffs) > 0 {
+		str := fmt.Sprintf(`values differ in %d places:
+%s`, len(diffs), diffTable(diffs))
+		fail(a.t, str, msg...)
+		return false
+	}
+	return true
 }
 
 // NotEqual asserts that the wrapped value v is not deeply equal to expect.
 // It reports an error if the values are deeply equal.
-func (a *ThatAssertion) NotEqual(expect interface{}, msg ...string) {
+func (a *ThatAssertion) NotEqual(expect interface{}, msg ...interface{}) (ok bool) {
 	a.t.Helper()
-	if reflect.DeepEqual(a.v, expect) {
-		str := fmt.Sprintf("got (%T) %v but expect not (%T) %v", a.v, a.v, expect, expect)
-		fail(a.t, str, msg...)
+	defer trackResult(a.t, a.v, &ok)()
+	return notEqual(a.t, a.v, expect, msg...)
+}
+
+// notEqual implements NotEqual.
+func notEqual(t internal.T, got, expect interface{}, msg ...interface{}) bool {
+	t.Helper()
+	equal, ok := equalValues(t, got, expect, msg...)
+	if !ok {
+		return false
+	}
+	if equal {
+		str := fmt.Sprintf("got (%T) %v but expect not (%T) %v", got, show(t, got), expect, show(t, expect))
+		failValues(t, got, expect, str, msg...)
+		return false
 	}
+	return true
 }
 
 // Same asserts that the wrapped value v and expect are the same (using Go ==).
 // It reports an error if v != expect.
-func (a *ThatAssertion) Same(expect interface{}, msg ...string) {
+func (a *ThatAssertion) Same(expect interface{}, msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	if a.v != expect {
-		str := fmt.Sprintf("got (%T) %v but expect (%T) %v", a.v, a.v, expect, expect)
-		fail(a.t, str, msg...)
+		str := fmt.Sprintf("got (%T) %v but expect (%T) %v", a.v, show(a.t, a.v), expect, show(a.t, expect))
+		failValues(a.t, a.v, expect, str, msg...)
+		return false
 	}
+	return true
 }
 
 // NotSame asserts that the wrapped value v and expect are not the same (using Go !=).
 // It reports an error if v == expect.
-func (a *ThatAssertion) NotSame(expect interface{}, msg ...string) {
+func (a *ThatAssertion) NotSame(expect interface{}, msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	if a.v == expect {
-		str := fmt.Sprintf("expect not (%T) %v", expect, expect)
+		str := fmt.Sprintf("expect not (%T) %v", expect, show(a.t, expect))
 		fail(a.t, str, msg...)
+		return false
 	}
+	return true
 }
 
 // TypeOf asserts that the type of the wrapped value v is assignable to the type of expect.
 // It supports pointer to interface types.
 // It reports an error if the types are not assignable.
-func (a *ThatAssertion) TypeOf(expect interface{}, msg ...string) {
+func (a *ThatAssertion) TypeOf(expect interface{}, msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 
 	e1 := reflect.TypeOf(a.v)
 	e2 := reflect.TypeOf(expect)
@@ -188,14 +467,18 @@ func (a *ThatAssertion) TypeOf(expect interface{}, msg ...string) {
 	if !e1.AssignableTo(e2) {
 		str := fmt.Sprintf("got type (%s) but expect type (%s)", e1, e2)
 		fail(a.t, str, msg...)
+		return false
 	}
+	return true
 }
 
 // Implements asserts that the type of the wrapped value v implements the interface type of expect.
 // The expect parameter must be an interface or pointer to interface.
 // It reports an error if v does not implement the interface.
-func (a *ThatAssertion) Implements(expect interface{}, msg ...string) {
+// See also the generic Implements function.
+func (a *ThatAssertion) Implements(expect interface{}, msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 
 	e1 := reflect.TypeOf(a.v)
 	e2 := reflect.TypeOf(expect)
@@ -204,197 +487,471 @@ func (a *ThatAssertion) Implements(expect interface{}, msg ...string) {
 			e2 = e2.Elem()
 		} else {
 			fail(a.t, "expect should be interface", msg...)
-			return
+			return false
 		}
 	}
 
 	if !e1.Implements(e2) {
 		str := fmt.Sprintf("got type (%s) but expect type (%s)", e1, e2)
 		fail(a.t, str, msg...)
+		return false
+	}
+	return true
+}
+
+// Implements asserts that the dynamic type of v implements the interface
+// type I, given as a type parameter instead of a (*I)(nil) pointer:
+//
+//	assert.Implements[io.ReadCloser](t, v)
+//
+// It reports an error listing the missing methods if it does not.
+func Implements[I any](t internal.T, v interface{}, msg ...interface{}) bool {
+	t.Helper()
+	defer track(t, v)()
+	it := reflect.TypeFor[I]()
+	if it.Kind() != reflect.Interface {
+		str := fmt.Sprintf("type parameter (%s) is not an interface", it)
+		fail(t, str, msg...)
+		return false
+	}
+	vt := reflect.TypeOf(v)
+	if vt == nil {
+		str := fmt.Sprintf(`type does not implement interface:
+    got: <nil>
+ expect: %s`, it)
+		fail(t, str, msg...)
+		return false
+	}
+	if vt.Implements(it) {
+		return true
+	}
+	var missing []string
+	for i := 0; i < it.NumMethod(); i++ {
+		m := it.Method(i)
+		if vm, ok := vt.MethodByName(m.Name); !ok || !sameSignature(vm.Type, m.Type) {
+			missing = append(missing, m.Name+strings.TrimPrefix(m.Type.String(), "func"))
+		}
+	}
+	str := fmt.Sprintf(`type does not implement interface:
+    got: (%s)
+ expect: %s
+missing: %s`, vt, it, strings.Join(missing, ", "))
+	fail(t, str, msg...)
+	return false
+}
+
+// sameSignature reports whether the method type m, whose first parameter
+// is the receiver, has the signature of the interface method type im.
+func sameSignature(m, im reflect.Type) bool {
+	if m.NumIn()-1 != im.NumIn() || m.NumOut() != im.NumOut() || m.IsVariadic() != im.IsVariadic() {
+		return false
+	}
+	for i := 0; i < im.NumIn(); i++ {
+		if m.In(i+1) != im.In(i) {
+			return false
+		}
 	}
+	for i := 0; i < im.NumOut(); i++ {
+		if m.Out(i) != im.Out(i) {
+			return false
+		}
+	}
+	return true
 }
 
 // Has asserts that the wrapped value v has a method named 'Has' that returns true when passed expect.
 // It reports an error if the method does not exist or returns false.
-func (a *ThatAssertion) Has(expect interface{}, msg ...string) {
+func (a *ThatAssertion) Has(expect interface{}, msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 
 	m := reflect.ValueOf(a.v).MethodByName("Has")
 	if !m.IsValid() {
 		str := fmt.Sprintf("method 'Has' not found on type %T", a.v)
-		fail(a.t, str, msg...)
-		return
+		failUsage(a.t, str, msg...)
+		return false
 	}
 
 	if m.Type().NumOut() != 1 || m.Type().Out(0).Kind() != reflect.Bool {
-		fail(a.t, "method 'Has' must return only a bool", msg...)
-		return
+		failUsage(a.t, "method 'Has' must return only a bool", msg...)
+		return false
 	}
 
 	ret := m.Call([]reflect.Value{reflect.ValueOf(expect)})
 	if !ret[0].Bool() {
-		str := fmt.Sprintf("got (%T) %v not has (%T) %v", a.v, a.v, expect, expect)
-		fail(a.t, str, msg...)
+		str := fmt.Sprintf("got (%T) %v not has (%T) %v", a.v, show(a.t, a.v), expect, show(a.t, expect))
+		failValues(a.t, a.v, expect, str, msg...)
+		return false
 	}
+	return true
 }
 
 // Contains asserts that the wrapped value v has a method named 'Contains' that returns true when passed expect.
 // It reports an error if the method does not exist or returns false.
-func (a *ThatAssertion) Contains(expect interface{}, msg ...string) {
+func (a *ThatAssertion) Contains(expect interface{}, msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 
 	m := reflect.ValueOf(a.v).MethodByName("Contains")
 	if !m.IsValid() {
 		str := fmt.Sprintf("method 'Contains' not found on type %T", a.v)
-		fail(a.t, str, msg...)
-		return
+		failUsage(a.t, str, msg...)
+		return false
 	}
 
 	if m.Type().NumOut() != 1 || m.Type().Out(0).Kind() != reflect.Bool {
-		fail(a.t, "method 'Contains' must return only a bool", msg...)
-		return
+		failUsage(a.t, "method 'Contains' must return only a bool", msg...)
+		return false
 	}
 
 	ret := m.Call([]reflect.Value{reflect.ValueOf(expect)})
 	if !ret[0].Bool() {
-		str := fmt.Sprintf("got (%T) %v not contains (%T) %v", a.v, a.v, expect, expect)
-		fail(a.t, str, msg...)
+		str := fmt.Sprintf("got (%T) %v not contains (%T) %v", a.v, show(a.t, a.v), expect, show(a.t, expect))
+		failValues(a.t, a.v, expect, str, msg...)
+		return false
 	}
+	return true
 }
 
 // InSlice asserts that the wrapped value v is present in the provided slice or array.
 // It reports an error if expect is not a slice/array or if v is not found.
-func (a *ThatAssertion) InSlice(expect interface{}, msg ...string) {
+func (a *ThatAssertion) InSlice(expect interface{}, msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 
 	v := reflect.ValueOf(expect)
 	if v.Kind() != reflect.Array && v.Kind() != reflect.Slice {
-		str := fmt.Sprintf("unsupported expect value (%T) %v", expect, expect)
-		fail(a.t, str, msg...)
-		return
+		str := fmt.Sprintf("unsupported expect value (%T) %v", expect, show(a.t, expect))
+		failUsage(a.t, str, msg...)
+		return false
 	}
 
 	for i := 0; i < v.Len(); i++ {
-		if reflect.DeepEqual(a.v, v.Index(i).Interface()) {
-			return
+		if deepEqual(a.v, v.Index(i).Interface()) {
+			return true
 		}
 	}
 
-	str := fmt.Sprintf("got (%T) %v is not in (%T) %v", a.v, a.v, expect, expect)
-	fail(a.t, str, msg...)
+	str := fmt.Sprintf("got (%T) %v is not in (%T) %v", a.v, show(a.t, a.v), expect, show(a.t, expect))
+	failValues(a.t, a.v, expect, str, msg...)
+	return false
 }
 
 // NotInSlice asserts that the wrapped value v is not present in the provided slice or array.
 // It reports an error if expect is not a slice/array, if types do not match, or if v is found.
-func (a *ThatAssertion) NotInSlice(expect interface{}, msg ...string) {
+func (a *ThatAssertion) NotInSlice(expect interface{}, msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 
 	v := reflect.ValueOf(expect)
 	if v.Kind() != reflect.Array && v.Kind() != reflect.Slice {
-		str := fmt.Sprintf("unsupported expect value (%T) %v", expect, expect)
-		fail(a.t, str, msg...)
-		return
+		str := fmt.Sprintf("unsupported expect value (%T) %v", expect, show(a.t, expect))
+		failUsage(a.t, str, msg...)
+		return false
 	}
 
 	e := reflect.TypeOf(a.v)
 	if e != v.Type().Elem() {
 		str := fmt.Sprintf("got type (%s) doesn't match expect type (%s)", e, v.Type())
-		fail(a.t, str, msg...)
-		return
+		failUsage(a.t, str, msg...)
+		return false
 	}
 
 	for i := 0; i < v.Len(); i++ {
-		if reflect.DeepEqual(a.v, v.Index(i).Interface()) {
-			str := fmt.Sprintf("got (%T) %v is in (%T) %v", a.v, a.v, expect, expect)
-			fail(a.t, str, msg...)
-			return
+		if deepEqual(a.v, v.Index(i).Interface()) {
+			str := fmt.Sprintf("got (%T) %v is in (%T) %v", a.v, show(a.t, a.v), expect, show(a.t, expect))
+			failValues(a.t, a.v, expect, str, msg...)
+			return false
 		}
 	}
+	return true
 }
 
 // InMapKeys asserts that the assertion’s value is one of the keys in the provided map.
 // It fails the test if the expected value is not a map or if the actual value
 // does not match any key in the map.
-func (a *ThatAssertion) InMapKeys(expect interface{}, msg ...string) {
+func (a *ThatAssertion) InMapKeys(expect interface{}, msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 
 	switch v := reflect.ValueOf(expect); v.Kind() {
 	case reflect.Map:
 		for _, key := range v.MapKeys() {
-			if reflect.DeepEqual(a.v, key.Interface()) {
-				return
+			if deepEqual(a.v, key.Interface()) {
+				return true
 			}
 		}
 	default:
-		str := fmt.Sprintf("unsupported expect value (%T) %v", expect, expect)
-		fail(a.t, str, msg...)
-		return
+		str := fmt.Sprintf("unsupported expect value (%T) %v", expect, show(a.t, expect))
+		failUsage(a.t, str, msg...)
+		return false
 	}
 
-	str := fmt.Sprintf("got (%T) %v is not in keys of (%T) %v", a.v, a.v, expect, expect)
-	fail(a.t, str, msg...)
+	str := fmt.Sprintf("got (%T) %v is not in keys of (%T) %v", a.v, show(a.t, a.v), expect, show(a.t, expect))
+	failValues(a.t, a.v, expect, str, msg...)
+	return false
 }
 
 // InMapValues asserts that the assertion’s value is one of the values in the provided map.
 // It fails the test if the expected value is not a map or if the actual value
 // does not match any value in the map.
-func (a *ThatAssertion) InMapValues(expect interface{}, msg ...string) {
+func (a *ThatAssertion) InMapValues(expect interface{}, msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 
 	switch v := reflect.ValueOf(expect); v.Kind() {
 	case reflect.Map:
 		for _, key := range v.MapKeys() {
 			val := v.MapIndex(key).Interface()
-			if reflect.DeepEqual(a.v, val) {
-				return
+			if deepEqual(a.v, val) {
+				return true
 			}
 		}
 	default:
-		str := fmt.Sprintf("unsupported expect value (%T) %v", expect, expect)
-		fail(a.t, str, msg...)
-		return
+		str := fmt.Sprintf("unsupported expect value (%T) %v", expect, show(a.t, expect))
+		failUsage(a.t, str, msg...)
+		return false
 	}
 
-	str := fmt.Sprintf("got (%T) %v is not in values of (%T) %v", a.v, a.v, expect, expect)
-	fail(a.t, str, msg...)
+	str := fmt.Sprintf("got (%T) %v is not in values of (%T) %v", a.v, show(a.t, a.v), expect, show(a.t, expect))
+	failValues(a.t, a.v, expect, str, msg...)
+	return false
 }
 
-// IsZero asserts that the wrapped value v is the zero value for its type.
-// It reports an error if the value is not zero.
-func (a *ThatAssertion) IsZero(msg ...string) {
+// IsZero asserts that the wrapped value v is the zero value for its type,
+// e.g. 0, "", a nil slice or a struct whose fields are all zero. An untyped
+// nil is zero as well. It reports an error if the value is not zero.
+func (a *ThatAssertion) IsZero(msg ...interface{}) (ok bool) {
 	a.t.Helper()
-	if !reflect.ValueOf(a.v).IsZero() {
-		str := fmt.Sprintf("got (%T) %v but expect zero value", a.v, a.v)
+	defer trackResult(a.t, a.v, &ok)()
+	if !isZero(a.v) {
+		str := fmt.Sprintf("got (%T) %v but expect zero value", a.v, show(a.t, a.v))
 		fail(a.t, str, msg...)
+		return false
 	}
+	return true
 }
 
 // NotZero asserts that the wrapped value v is not the zero value for its type.
 // It reports an error if the value is zero.
-func (a *ThatAssertion) NotZero(msg ...string) {
+func (a *ThatAssertion) NotZero(msg ...interface{}) (ok bool) {
 	a.t.Helper()
-	if reflect.ValueOf(a.v).IsZero() {
+	defer trackResult(a.t, a.v, &ok)()
+	if isZero(a.v) {
 		str := fmt.Sprintf("got zero value but expect not zero for type %T", a.v)
 		fail(a.t, str, msg...)
+		return false
+	}
+	return true
+}
+
+// Field returns a ThatAssertion over the field of the wrapped struct value v
+// at the given dotted path, e.g. "User.Address.City", see ThatStruct. A test
+// failure is reported if the path cannot be resolved, and the checks of the
+// returned assertion are then skipped.
+func (a *ThatAssertion) Field(path string, msg ...interface{}) *ThatAssertion {
+	a.t.Helper()
+	c := chain(a.t)
+	v, ok := ThatStruct(c, a.v).field(path, msg...)
+	if !ok {
+		return &ThatAssertion{t: c}
+	}
+	return &ThatAssertion{t: c, v: v.Interface()}
+}
+
+// IsEmpty asserts that the wrapped value v is empty: nil, or a string,
+// array, slice, map or channel of length zero. It reports an error if the
+// value is not empty or has no length.
+func (a *ThatAssertion) IsEmpty(msg ...interface{}) (ok bool) {
+	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
+	n, ok := lengthOf(a.v)
+	if !ok {
+		str := fmt.Sprintf("unsupported value (%T) %v", a.v, show(a.t, a.v))
+		failUsage(a.t, str, msg...)
+		return false
+	}
+	if n != 0 {
+		str := fmt.Sprintf("got (%T) %v is not empty", a.v, show(a.t, a.v))
+		fail(a.t, str, msg...)
+		return false
+	}
+	return true
+}
+
+// IsNotEmpty asserts that the wrapped value v is a string, array, slice,
+// map or channel of non-zero length. It reports an error otherwise.
+func (a *ThatAssertion) IsNotEmpty(msg ...interface{}) (ok bool) {
+	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
+	n, ok := lengthOf(a.v)
+	if !ok {
+		str := fmt.Sprintf("unsupported value (%T) %v", a.v, show(a.t, a.v))
+		failUsage(a.t, str, msg...)
+		return false
+	}
+	if n == 0 {
+		str := fmt.Sprintf("got (%T) %v is empty", a.v, show(a.t, a.v))
+		fail(a.t, str, msg...)
+		return false
+	}
+	return true
+}
+
+// HasLen asserts that the wrapped value v, a string, array, slice, map or
+// channel, has the given length. It reports an error with the actual length
+// otherwise.
+func (a *ThatAssertion) HasLen(length int, msg ...interface{}) (ok bool) {
+	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
+	n, ok := lengthOf(a.v)
+	if !ok {
+		str := fmt.Sprintf("unsupported value (%T) %v", a.v, show(a.t, a.v))
+		failUsage(a.t, str, msg...)
+		return false
+	}
+	if n != length {
+		str := fmt.Sprintf("got (%T) %v of length %d but expect length %d", a.v, show(a.t, a.v), n, length)
+		fail(a.t, str, msg...)
+		return false
 	}
+	return true
+}
+
+// lengthOf returns the length of v, which is zero for nil and nil pointers,
+// or false if v has no length.
+func lengthOf(v interface{}) (int, bool) {
+	if v == nil {
+		return 0, true
+	}
+	switch rv := reflect.ValueOf(v); rv.Kind() {
+	case reflect.String, reflect.Array, reflect.Slice, reflect.Map, reflect.Chan:
+		return rv.Len(), true
+	case reflect.Ptr:
+		if rv.IsNil() {
+			return 0, true
+		}
+	}
+	return 0, false
+}
+
+// Satisfies asserts that pred holds for the wrapped value v. It reports an
+// error stating desc, which describes the condition, otherwise.
+func (a *ThatAssertion) Satisfies(pred func(v interface{}) bool, desc string, msg ...interface{}) (ok bool) {
+	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
+	return satisfies(a.t, a.v, pred(a.v), desc, msg...)
+}
+
+// satisfies implements Satisfies for the value v for which the predicate
+// returned ok.
+func satisfies(t internal.T, v interface{}, ok bool, desc string, msg ...interface{}) bool {
+	t.Helper()
+	if !ok {
+		str := fmt.Sprintf(`value does not satisfy the condition:
+    got: (%T) %v
+ expect: %s`, v, show(t, v), desc)
+		fail(t, str, msg...)
+		return false
+	}
+	return true
+}
+
+// isZero reports whether v is nil or the zero value for its type.
+func isZero(v interface{}) bool {
+	return v == nil || reflect.ValueOf(v).IsZero()
+}
+
+// IsKind asserts that the wrapped value v is of the given kind, e.g.
+// reflect.Slice. An untyped nil is of kind reflect.Invalid. It reports an
+// error if the kinds differ.
+func (a *ThatAssertion) IsKind(kind reflect.Kind, msg ...interface{}) (ok bool) {
+	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
+	return isKind(a.t, a.v, kind, msg...)
+}
+
+// IsSlice asserts that the wrapped value v is a slice.
+func (a *ThatAssertion) IsSlice(msg ...interface{}) (ok bool) {
+	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
+	return isKind(a.t, a.v, reflect.Slice, msg...)
+}
+
+// IsMap asserts that the wrapped value v is a map.
+func (a *ThatAssertion) IsMap(msg ...interface{}) (ok bool) {
+	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
+	return isKind(a.t, a.v, reflect.Map, msg...)
+}
+
+// IsPointer asserts that the wrapped value v is a pointer.
+func (a *ThatAssertion) IsPointer(msg ...interface{}) (ok bool) {
+	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
+	return isKind(a.t, a.v, reflect.Ptr, msg...)
+}
+
+// IsFunc asserts that the wrapped value v is a function.
+func (a *ThatAssertion) IsFunc(msg ...interface{}) (ok bool) {
+	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
+	return isKind(a.t, a.v, reflect.Func, msg...)
+}
+
+// isKind implements IsKind.
+func isKind(t internal.T, v interface{}, kind reflect.Kind, msg ...interface{}) bool {
+	t.Helper()
+	if got := reflect.ValueOf(v).Kind(); got != kind {
+		str := fmt.Sprintf("got (%T) %v of kind %s but expect kind %s", v, show(t, v), got, kind)
+		fail(t, str, msg...)
+		return false
+	}
+	return true
 }
 
 // IsType asserts that the wrapped value v is of the same type as expect.
 // It reports an error if the types are not the same.
-func (a *ThatAssertion) IsType(expect interface{}, msg ...string) {
+func (a *ThatAssertion) IsType(expect interface{}, msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	if reflect.TypeOf(a.v) != reflect.TypeOf(expect) {
 		str := fmt.Sprintf("got type (%s) but expect type (%s)", reflect.TypeOf(a.v), reflect.TypeOf(expect))
 		fail(a.t, str, msg...)
+		return false
 	}
+	return true
 }
 
 // IsNotType asserts that the wrapped value v is not of the same type as expect.
 // It reports an error if the types are the same.
-func (a *ThatAssertion) IsNotType(expect interface{}, msg ...string) {
+func (a *ThatAssertion) IsNotType(expect interface{}, msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	if reflect.TypeOf(a.v) == reflect.TypeOf(expect) {
 		str := fmt.Sprintf("got type (%s) but expect not type (%s)", reflect.TypeOf(a.v), reflect.TypeOf(expect))
 		fail(a.t, str, msg...)
+		return false
+	}
+	return true
+}
+
+// AsString returns a StringAssertion over the textual representation of the
+// wrapped value v: the result of its String method if it is a fmt.Stringer,
+// of its Error method if it is an error, and its %v formatting otherwise.
+// A nil pointer is "<nil>", as its methods may not accept a nil receiver.
+func (a *ThatAssertion) AsString() *StringAssertion {
+	if rv := reflect.ValueOf(a.v); rv.Kind() == reflect.Ptr && rv.IsNil() {
+		return ThatString(a.t, "<nil>")
+	}
+	var s string
+	switch v := a.v.(type) {
+	case fmt.Stringer:
+		s = v.String()
+	case error:
+		s = v.Error()
+	default:
+		s = fmt.Sprintf("%v", v)
 	}
+	return ThatString(a.t, s)
 }
@@ -21,146 +21,162 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math"
+	"os"
+	"reflect"
 	"slices"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/lvan100/go-assert"
-	"github.com/lvan100/go-assert/internal"
+	"github.com/lvan100/go-assert/internal/mock"
 	"go.uber.org/mock/gomock"
 )
 
-func runCase(t *testing.T, f func(g *internal.MockT)) {
+func TestMain(m *testing.M) {
+	// expected failure messages are plain text, whatever the terminal
+	assert.Configure(func(s *assert.Settings) {
+		s.Color = assert.ColorNever
+	})
+	// and do not reference artifacts of the CI environment
+	_ = os.Unsetenv(assert.ArtifactsEnv)
+	os.Exit(m.Run())
+}
+
+func runCase(t *testing.T, f func(g *mock.MockT)) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
-	g := internal.NewMockT(ctrl)
+	g := mock.NewMockT(ctrl)
 	g.EXPECT().Helper().AnyTimes()
 	f(g)
 }
 
 func TestTrue(t *testing.T) {
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		assert.True(g, true)
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{"got false but expect true"})
 		assert.True(g, false)
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{"got false but expect true, param (index=0)"})
 		assert.True(g, false, "param (index=0)")
 	})
 }
 
 func TestFalse(t *testing.T) {
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		assert.False(g, false)
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{"got true but expect false"})
 		assert.False(g, true)
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{"got true but expect false, param (index=0)"})
 		assert.False(g, true, "param (index=0)")
 	})
 }
 
 func TestNil(t *testing.T) {
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		assert.Nil(g, nil)
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		assert.Nil(g, (*int)(nil))
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		var a []string
 		assert.Nil(g, a)
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		var m map[string]string
 		assert.Nil(g, m)
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{"got (int) 3 but expect nil"})
 		assert.Nil(g, 3)
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{"got (int) 3 but expect nil, param (index=0)"})
 		assert.Nil(g, 3, "param (index=0)")
 	})
 }
 
 func TestNotNil(t *testing.T) {
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		assert.NotNil(g, 3)
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		a := make([]string, 0)
 		assert.NotNil(g, a)
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		m := make(map[string]string)
 		assert.NotNil(g, m)
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{"got nil but expect not nil"})
 		assert.NotNil(g, nil)
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{"got nil but expect not nil, param (index=0)"})
 		assert.NotNil(g, nil, "param (index=0)")
 	})
 }
 
 func TestPanic(t *testing.T) {
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		assert.Panic(g, func() { panic("this is an error") }, "an error")
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{"did not panic"})
 		assert.Panic(g, func() {}, "an error")
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{"invalid pattern"})
 		assert.Panic(g, func() { panic("this is an error") }, "an error \\")
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{"got \"there's no error\" which does not match \"an error\""})
 		assert.Panic(g, func() { panic("there's no error") }, "an error")
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{"got \"there's no error\" which does not match \"an error\", param (index=0)"})
 		assert.Panic(g, func() { panic("there's no error") }, "an error", "param (index=0)")
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{"got \"there's no error\" which does not match \"an error\""})
 		assert.Panic(g, func() { panic(errors.New("there's no error")) }, "an error")
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{"got \"there's no error\" which does not match \"an error\""})
 		assert.Panic(g, func() { panic(bytes.NewBufferString("there's no error")) }, "an error")
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{"got \"[there's no error]\" which does not match \"an error\""})
 		assert.Panic(g, func() { panic([]string{"there's no error"}) }, "an error")
 	})
 }
 
 func TestThat_Equal(t *testing.T) {
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		assert.That(g, 0).Equal(0)
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		assert.That(g, []string{"a"}).Equal([]string{"a"})
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		assert.That(g, struct {
 			text string
 		}{text: "a"}).Equal(struct {
 			text string
 		}{text: "a"})
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{"got (struct { Text string }) {a} but expect (struct { Text string \"json:\\\"text\\\"\" }) {a}"})
 		assert.That(g, struct {
 			Text string
@@ -168,7 +184,7 @@ func TestThat_Equal(t *testing.T) {
 			Text string `json:"text"`
 		}{Text: "a"})
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{"got (struct { text string }) {a} but expect (struct { msg string }) {a}"})
 		assert.That(g, struct {
 			text string
@@ -176,81 +192,285 @@ func TestThat_Equal(t *testing.T) {
 			msg string
 		}{msg: "a"})
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{"got (int) 0 but expect (string) 0"})
 		assert.That(g, 0).Equal("0")
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{"got (int) 0 but expect (string) 0, param (index=0)"})
 		assert.That(g, 0).Equal("0", "param (index=0)")
 	})
 }
 
+func TestThat_EqualIgnoring(t *testing.T) {
+	type Item struct {
+		ID   int
+		Name string
+	}
+	type Order struct {
+		ID        int
+		CreatedAt time.Time
+		Items     []Item
+		Meta      map[string]*Item
+		secret    string
+	}
+	got := Order{
+		ID:        7,
+		CreatedAt: time.Now(),
+		Items:     []Item{{ID: 1, Name: "pen"}, {ID: 2, Name: "ink"}},
+		Meta:      map[string]*Item{"gift": {ID: 3, Name: "box"}},
+		secret:    "x",
+	}
+	expect := Order{
+		Items:  []Item{{Name: "pen"}, {Name: "ink"}},
+		Meta:   map[string]*Item{"gift": {Name: "box"}},
+		secret: "x",
+	}
+
+	runCase(t, func(g *mock.MockT) {
+		assert.That(g, got).EqualIgnoring(expect, assert.IgnoringFields("ID", "CreatedAt", "Items.ID", "Meta.ID"))
+	})
+	runCase(t, func(g *mock.MockT) {
+		// pointers, at the root and along the paths, are followed
+		assert.That(g, &got).EqualIgnoring(&expect, assert.IgnoringFields("ID", "CreatedAt", "Items.ID", "Meta.ID"))
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`values not equal ignoring ["ID" "CreatedAt" "Meta.ID"]:
+   path: Items[0].ID
+    got: (int) 1
+ expect: (int) 0`})
+		assert.That(g, got).EqualIgnoring(expect, assert.IgnoringFields("ID", "CreatedAt", "Meta.ID"))
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`values not equal ignoring ["ID" "CreatedAt" "Items.ID" "Meta.ID"]:
+   path: Items[1].Name
+    got: (string) ink
+ expect: (string) ink!`})
+		e := expect
+		e.Items = []Item{{Name: "pen"}, {Name: "ink!"}}
+		assert.That(g, got).EqualIgnoring(e, assert.IgnoringFields("ID", "CreatedAt", "Items.ID", "Meta.ID"))
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`values not equal ignoring ["ID" "CreatedAt" "Items.ID" "Meta.ID"]:
+   path: secret
+    got: (string) x
+ expect: (string) y`})
+		e := expect
+		e.secret = "y"
+		assert.That(g, got).EqualIgnoring(e, assert.IgnoringFields("ID", "CreatedAt", "Items.ID", "Meta.ID"))
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`values not equal ignoring ["ID"]:
+   path: Items
+   note: length 2 but expect length 1
+    got: ([]assert_test.Item) [{1 pen} {2 ink}]
+ expect: ([]assert_test.Item) [{0 pen}]`})
+		assert.That(g, Order{Items: got.Items}).EqualIgnoring(Order{Items: expect.Items[:1]}, assert.IgnoringFields("ID"))
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`values not equal ignoring []:
+   path: [b]
+   note: missing key
+ expect: (int) 2
+message: counts`})
+		assert.That(g, map[string]int{"a": 1}).EqualIgnoring(map[string]int{"a": 1, "b": 2}, nil, "counts")
+	})
+}
+
+func TestThat_EqualGraph(t *testing.T) {
+	type Node struct {
+		Name string
+		Next *Node
+	}
+	type Graph struct {
+		A, B *Node
+	}
+	shared := &Node{Name: "x"}
+	got := Graph{A: shared, B: shared}
+	twins := Graph{A: &Node{Name: "x"}, B: &Node{Name: "x"}}
+
+	runCase(t, func(g *mock.MockT) {
+		other := &Node{Name: "x"}
+		assert.That(g, got).EqualGraph(Graph{A: other, B: other})
+		assert.That(g, twins).EqualGraph(Graph{A: &Node{Name: "x"}, B: &Node{Name: "x"}})
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`object graphs not equal:
+   path: B
+   note: got aliases A, expect does not
+    got: (*assert_test.Node) &{x <nil>}
+ expect: (*assert_test.Node) &{x <nil>}`})
+		assert.That(g, got).EqualGraph(twins)
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`object graphs not equal:
+   path: B
+   note: expect aliases A, got does not
+    got: (*assert_test.Node) &{x <nil>}
+ expect: (*assert_test.Node) &{x <nil>}
+message: cache must share nodes`})
+		assert.That(g, twins).EqualGraph(got, "cache must share nodes")
+	})
+	runCase(t, func(g *mock.MockT) {
+		a := &Node{Name: "a"}
+		a.Next = a
+		b := &Node{Name: "a"}
+		b.Next = b
+		assert.That(g, a).EqualGraph(b)
+	})
+}
+
+func TestThat_EqualExported(t *testing.T) {
+	type Counter struct {
+		Name  string
+		Tags  []string
+		mu    sync.Mutex
+		count int
+	}
+	got := &Counter{Name: "hits", Tags: []string{"a"}, count: 3}
+	runCase(t, func(g *mock.MockT) {
+		assert.That(g, got).EqualExported(&Counter{Name: "hits", Tags: []string{"a"}})
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`exported fields not equal:
+   path: Tags[0]
+    got: (string) a
+ expect: (string) b
+message: counter`})
+		assert.That(g, got).EqualExported(&Counter{Name: "hits", Tags: []string{"b"}}, "counter")
+	})
+}
+
+func TestThat_DiffReport(t *testing.T) {
+	type Account struct {
+		ID      int
+		Owner   string
+		Balance float64
+		Labels  map[string]string
+		Tags    []string
+		cache   []byte
+	}
+	got := Account{ID: 1, Owner: "bob", Balance: 10.5,
+		Labels: map[string]string{"tier": "gold", "region": "eu"},
+		Tags:   []string{"a", "b"}, cache: []byte("x")}
+
+	runCase(t, func(g *mock.MockT) {
+		e := got
+		e.cache = nil // unexported fields are not compared
+		assert.That(g, got).DiffReport(e)
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`values differ in 5 places:
+    path           | got   | expect
+    Owner          | bob   | alice
+    Balance        | 10.5  | 12
+    Labels[region] | eu    | <missing>
+    Labels[tier]   | gold  | silver
+    Tags           | [a b] | [a]
+message: account mapping`})
+		assert.That(g, got).DiffReport(Account{ID: 1, Owner: "alice", Balance: 12,
+			Labels: map[string]string{"tier": "silver"},
+			Tags:   []string{"a"}}, "account mapping")
+	})
+
+	defer assert.Configure(func(s *assert.Settings) {
+		s.MaxDiffs = 2
+	})()
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`values differ in 4 places:
+    path | got | expect
+    [0]  | 1   | 0
+    [1]  | 2   | 0
+    …and 2 more differences`})
+		assert.That(g, []int{1, 2, 3, 4}).DiffReport([]int{0, 0, 0, 0})
+	})
+}
+
+func TestThat_EqualScalars(t *testing.T) {
+	type myInt int
+	runCase(t, func(g *mock.MockT) {
+		assert.That(g, "a").Equal("a")
+		assert.That(g, 1.5).Equal(1.5)
+		assert.That(g, myInt(1)).Equal(myInt(1))
+		assert.That(g, 1).NotEqual(int64(1))
+		assert.That(g, myInt(1)).NotEqual(1)
+		assert.That(g, math.NaN()).NotEqual(math.NaN())
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"got (int) 1 but expect (int64) 1"})
+		assert.That(g, 1).Equal(int64(1))
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"got (uint8) 1 but expect (<nil>) <nil>"})
+		assert.That(g, uint8(1)).Equal(nil)
+	})
+}
+
 func TestThat_NotEqual(t *testing.T) {
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		assert.That(g, "0").NotEqual(0)
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{"got ([]string) [a] but expect not ([]string) [a]"})
 		assert.That(g, []string{"a"}).NotEqual([]string{"a"})
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{"got (string) 0 but expect not (string) 0"})
 		assert.That(g, "0").NotEqual("0")
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{"got (string) 0 but expect not (string) 0, param (index=0)"})
 		assert.That(g, "0").NotEqual("0", "param (index=0)")
 	})
 }
 
 func TestThat_Same(t *testing.T) {
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		assert.That(g, "0").Same("0")
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{"got (int) 0 but expect (string) 0"})
 		assert.That(g, 0).Same("0")
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{"got (int) 0 but expect (string) 0, param (index=0)"})
 		assert.That(g, 0).Same("0", "param (index=0)")
 	})
 }
 
 func TestThat_NotSame(t *testing.T) {
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		assert.That(g, "0").NotSame(0)
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{"expect not (string) 0"})
 		assert.That(g, "0").NotSame("0")
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{"expect not (string) 0, param (index=0)"})
 		assert.That(g, "0").NotSame("0", "param (index=0)")
 	})
 }
 
 func TestThat_TypeOf(t *testing.T) {
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		assert.That(g, new(int)).TypeOf((*int)(nil))
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{"got type (string) but expect type (fmt.Stringer)"})
 		assert.That(g, "string").TypeOf((*fmt.Stringer)(nil))
 	})
 }
 
 func TestThat_Implements(t *testing.T) {
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		assert.That(g, errors.New("error")).Implements((*error)(nil))
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{"expect should be interface"})
 		assert.That(g, new(int)).Implements((*int)(nil))
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{"got type (*int) but expect type (io.Reader)"})
 		assert.That(g, new(int)).Implements((*io.Reader)(nil))
 	})
@@ -278,105 +498,366 @@ func (t *Tree) Contains(key string) bool {
 	return slices.Contains(t.Keys, key)
 }
 
+func TestImplements(t *testing.T) {
+	runCase(t, func(g *mock.MockT) {
+		assert.Implements[io.Reader](g, &bytes.Buffer{})
+		assert.Implements[error](g, errors.New("x"))
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`type does not implement interface:
+    got: (*bytes.Buffer)
+ expect: io.ReadWriteCloser
+missing: Close() error`})
+		assert.Implements[io.ReadWriteCloser](g, &bytes.Buffer{})
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`type does not implement interface:
+    got: (bytes.Buffer)
+ expect: io.Reader
+missing: Read([]uint8) (int, error)
+message: buffer must be passed by pointer`})
+		assert.Implements[io.Reader](g, bytes.Buffer{}, "buffer must be passed by pointer")
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`type does not implement interface:
+    got: <nil>
+ expect: io.Reader`})
+		assert.Implements[io.Reader](g, nil)
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"type parameter (int) is not an interface"})
+		assert.Implements[int](g, 3)
+	})
+}
+
 func TestThat_Has(t *testing.T) {
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{"method 'Has' not found on type int"})
 		assert.That(g, 1).Has("1")
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{"method 'Has' must return only a bool"})
 		assert.That(g, &Node{}).Has("2")
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{"got (*assert_test.Tree) &{[]} not has (string) 2"})
 		assert.That(g, &Tree{}).Has("2")
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		assert.That(g, &Tree{Keys: []string{"1"}}).Has("1")
 	})
 }
 
 func TestThat_Contains(t *testing.T) {
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{"method 'Contains' not found on type int"})
 		assert.That(g, 1).Contains("1")
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{"method 'Contains' must return only a bool"})
 		assert.That(g, &Node{}).Contains("2")
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{"got (*assert_test.Tree) &{[]} not contains (string) 2"})
 		assert.That(g, &Tree{}).Contains("2")
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		assert.That(g, &Tree{Keys: []string{"1"}}).Contains("1")
 	})
 }
 
 func TestThat_InSlice(t *testing.T) {
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{"unsupported expect value (string) 1"})
 		assert.That(g, 1).InSlice("1")
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{"got (int) 1 is not in ([]string) [1]"})
 		assert.That(g, 1).InSlice([]string{"1"})
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{"got (int64) 1 is not in ([]int64) [3 2]"})
 		assert.That(g, int64(1)).InSlice([]int64{3, 2})
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		assert.That(g, int64(1)).InSlice([]int64{3, 2, 1})
 		assert.That(g, "1").InSlice([]string{"3", "2", "1"})
 	})
 }
 
 func TestThat_NotInSlice(t *testing.T) {
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{"unsupported expect value (string) 1"})
 		assert.That(g, 1).NotInSlice("1")
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{"got type (int) doesn't match expect type ([]string)"})
 		assert.That(g, 1).NotInSlice([]string{"1"})
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{"got (string) 1 is in ([]string) [3 2 1]"})
 		assert.That(g, "1").NotInSlice([]string{"3", "2", "1"})
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		assert.That(g, int64(1)).NotInSlice([]int64{3, 2})
 	})
 }
 
 func TestThat_InMapKeys(t *testing.T) {
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{"unsupported expect value (string) 1"})
 		assert.That(g, 1).InMapKeys("1")
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{"got (int) 1 is not in keys of (map[string]string) map[1:1]"})
 		assert.That(g, 1).InMapKeys(map[string]string{"1": "1"})
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		assert.That(g, int64(1)).InMapKeys(map[int64]int64{3: 1, 2: 2, 1: 3})
 		assert.That(g, "1").InMapKeys(map[string]string{"3": "1", "2": "2", "1": "3"})
 	})
 }
 
 func TestThat_InMapValues(t *testing.T) {
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{"unsupported expect value (string) 1"})
 		assert.That(g, 1).InMapValues("1")
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{"got (int) 1 is not in values of (map[string]string) map[1:1]"})
 		assert.That(g, 1).InMapValues(map[string]string{"1": "1"})
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		assert.That(g, int64(1)).InMapValues(map[int64]int64{3: 1, 2: 2, 1: 3})
 		assert.That(g, "1").InMapValues(map[string]string{"3": "1", "2": "2", "1": "3"})
 	})
 }
+
+func TestThat_IsZero(t *testing.T) {
+	runCase(t, func(g *mock.MockT) {
+		assert.That(g, 0).IsZero()
+		assert.That(g, "").IsZero()
+		assert.That(g, struct{ A int }{}).IsZero()
+		assert.That(g, []int(nil)).IsZero()
+		assert.That(g, nil).IsZero()
+		assert.That(g, []int{}).NotZero()
+		assert.That(g, struct{ A int }{1}).NotZero()
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"got (string) a but expect zero value"})
+		g.EXPECT().Error([]interface{}{"got zero value but expect not zero for type <nil>"})
+		assert.That(g, "a").IsZero()
+		assert.That(g, nil).NotZero()
+	})
+}
+
+func TestThat_IsEmpty(t *testing.T) {
+	runCase(t, func(g *mock.MockT) {
+		assert.That(g, nil).IsEmpty()
+		assert.That(g, "").IsEmpty()
+		assert.That(g, [0]int{}).IsEmpty()
+		assert.That(g, map[string]int(nil)).IsEmpty()
+		assert.That(g, make(chan int, 1)).IsEmpty()
+		assert.That(g, (*int)(nil)).IsEmpty()
+		assert.That(g, []int{1}).IsNotEmpty()
+		assert.That(g, "a").IsNotEmpty()
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"got (map[string]int) map[a:1] is not empty"})
+		g.EXPECT().Error([]interface{}{"got ([]int) [] is empty"})
+		g.EXPECT().Error([]interface{}{"unsupported value (int) 0"})
+		assert.That(g, map[string]int{"a": 1}).IsEmpty()
+		assert.That(g, []int{}).IsNotEmpty()
+		assert.That(g, 0).IsEmpty()
+	})
+}
+
+func TestThat_HasLen(t *testing.T) {
+	runCase(t, func(g *mock.MockT) {
+		assert.That(g, "abc").HasLen(3)
+		assert.That(g, [2]int{}).HasLen(2)
+		assert.That(g, map[int]int{1: 1}).HasLen(1)
+		assert.That(g, nil).HasLen(0)
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"got ([]int) [1 2] of length 2 but expect length 3"})
+		g.EXPECT().Error([]interface{}{"unsupported value (bool) true"})
+		assert.That(g, []int{1, 2}).HasLen(3)
+		assert.That(g, true).HasLen(1)
+	})
+}
+
+func TestThat_Satisfies(t *testing.T) {
+	even := func(v interface{}) bool { return v.(int)%2 == 0 }
+	runCase(t, func(g *mock.MockT) {
+		assert.That(g, 4).Satisfies(even, "an even number")
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`value does not satisfy the condition:
+    got: (int) 3
+ expect: an even number
+message: count`})
+		assert.False(t, assert.That(g, 3).Satisfies(even, "an even number", "count"))
+	})
+}
+
+func TestThat_Field(t *testing.T) {
+	type Address struct{ City string }
+	type User struct {
+		Name    string
+		Address *Address
+	}
+	type Order struct{ User User }
+	o := Order{User: User{Name: "bob", Address: &Address{City: "Paris"}}}
+	runCase(t, func(g *mock.MockT) {
+		assert.That(g, o).Field("User.Address.City").Equal("Paris")
+		assert.That(g, &o).Field("User.Name").NotEqual("alice")
+	})
+	runCase(t, func(g *mock.MockT) {
+		// unexported fields of structs passed by value or held by interfaces
+		type secret struct{ key string }
+		type wrapper struct{ inner interface{} }
+		assert.That(g, secret{key: "k"}).Field("key").Equal("k")
+		assert.That(g, wrapper{inner: secret{key: "k"}}).Field("inner.key").Equal("k")
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"got (string) Paris but expect (string) Rome"})
+		assert.That(g, o).Field("User.Address.City").Equal("Rome")
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`field not found:
+ struct: assert_test.Order
+  field: User.Zip
+  error: no field "Zip" in (assert_test.User)`})
+		// the checks on a missing field are skipped
+		assert.That(g, o).Field("User.Zip").Equal("75001")
+	})
+}
+
+func TestThat_IsKind(t *testing.T) {
+	runCase(t, func(g *mock.MockT) {
+		assert.That(g, uint8(1)).IsKind(reflect.Uint8)
+		assert.That(g, nil).IsKind(reflect.Invalid)
+		assert.That(g, []int(nil)).IsSlice()
+		assert.That(g, map[string]int{}).IsMap()
+		assert.That(g, new(int)).IsPointer()
+		assert.That(g, TestThat_IsKind).IsFunc()
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"got ([2]int) [1 2] of kind array but expect kind slice"})
+		g.EXPECT().Error([]interface{}{"got (<nil>) <nil> of kind invalid but expect kind ptr"})
+		assert.That(g, [2]int{1, 2}).IsSlice()
+		assert.That(g, nil).IsPointer()
+	})
+}
+
+type point struct{ X, Y int }
+
+func (p *point) String() string {
+	return fmt.Sprintf("(%d, %d)", p.X, p.Y)
+}
+
+func TestThat_AsString(t *testing.T) {
+	runCase(t, func(g *mock.MockT) {
+		assert.That(g, time.Duration(1500)*time.Millisecond).AsString().Equal("1.5s")
+		assert.That(g, errors.New("not found")).AsString().HasSuffix("found")
+		assert.That(g, []int{1, 2}).AsString().Equal("[1 2]")
+		assert.That(g, nil).AsString().Equal("<nil>")
+		var p *point
+		assert.That(g, p).AsString().Equal("<nil>")
+		var e *os.PathError
+		assert.That(g, e).AsString().Equal("<nil>")
+	})
+
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`string does not match the pattern:
+    got: (string) "[1 2]"
+ expect: to match regex "^\\d+$"`})
+		assert.That(g, []int{1, 2}).AsString().Matches(`^\d+$`)
+	})
+}
+
+func TestThat_EqualDiff(t *testing.T) {
+	type Address struct{ City string }
+	type User struct {
+		Name    string
+		Tags    []string
+		Address *Address
+	}
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`values not equal:
+   type: (assert_test.User)
+   diff: (-expect +got)
+  assert_test.User{
+    Name: "bob",
+    Tags: []string{
+      "a",
+-     "c",
++     "b",
+    },
+    Address: &assert_test.Address{
+-     City: "Paris",
++     City: "Rome",
+    },
+  }`})
+		got := User{Name: "bob", Tags: []string{"a", "b"}, Address: &Address{City: "Rome"}}
+		expect := User{Name: "bob", Tags: []string{"a", "c"}, Address: &Address{City: "Paris"}}
+		assert.That(g, got).Equal(expect)
+	})
+
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"got (int) 1 but expect (int64) 1"})
+		assert.That(g, 1).Equal(int64(1))
+	})
+
+	// values too long to be diffed are reported whole
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error(gomock.Cond(func(args []interface{}) bool {
+			str := args[0].(string)
+			return strings.HasPrefix(str, "got ([]int) [0 1 2 ") &&
+				!strings.Contains(str, "diff:")
+		}))
+		got := make([]int, 6000)
+		for i := range got {
+			got[i] = i
+		}
+		expect := slices.Clone(got)
+		expect[10] = -1
+		assert.That(g, got).Equal(expect)
+	})
+}
+
+func TestMessageArgs(t *testing.T) {
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"got (int) 1 but expect (int) 2\nmessage: case 3: users"})
+		assert.That(g, 1).Equal(2, "case %d: %s", 3, "users")
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"got false but expect true\nmessage: first, second"})
+		assert.True(g, false, "first", "second")
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"got false but expect true\nmessage: 100%, 42"})
+		assert.True(g, false, "100%", 42)
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"got true but expect false\nmessage: 42"})
+		assert.False(g, true, 42)
+	})
+}
+
+func TestResults(t *testing.T) {
+	runCase(t, func(g *mock.MockT) {
+		assert.True(t, assert.NotNil(g, 1))
+		assert.True(t, assert.That(g, 1).Equal(1))
+		assert.True(t, assert.ThatSlice(g, []int{1}).Contains(1))
+		assert.True(t, assert.ThatError(g, errors.New("boom")).Matches("bo+m"))
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error(gomock.Any()).Times(4)
+		assert.False(t, assert.NotNil(g, nil))
+		assert.False(t, assert.That(g, 1).Equal(2))
+		assert.False(t, assert.ThatMap(g, map[string]int{}).Contains("a"))
+		assert.False(t, assert.Panic(g, func() { panic("boom") }, "bang"))
+	})
+}
@@ -0,0 +1,317 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/lvan100/go-assert/internal"
+)
+
+// Asserter wraps a test handler with per-asserter options that take
+// precedence over the package-level Settings. It implements the test handler
+// interface itself, so it is passed wherever t is expected:
+//
+//	a := assert.New(t).WithVerbosity(assert.VerbosityFull)
+//	assert.That(a, got).Equal(expect)
+type Asserter struct {
+	t              internal.T
+	verbosity      *Verbosity
+	color          *ColorMode
+	stackTrace     *bool
+	context        []string
+	phase          string
+	logPasses      *bool
+	failFast       *bool
+	callerLocation *bool
+	continueChains *bool
+	cmpOptions     []cmp.Option
+	fuzzInput      []interface{}
+	reporters      []Reporter
+}
+
+// New returns an Asserter for the given test handler without any options set.
+func New(t internal.T) *Asserter {
+	return &Asserter{t: t}
+}
+
+// Must returns an Asserter whose failing assertions stop the test at once
+// with t.Fatal, whatever the fail-fast setting, for preconditions that the
+// rest of the test depends on:
+//
+//	assert.ThatError(assert.Must(t), err).IsNil()
+//	assert.That(assert.Must(t), cfg).NotNil()
+func Must(t internal.T) *Asserter {
+	return New(t).WithFailFast(true)
+}
+
+// Helper marks the calling function as a test helper function.
+func (a *Asserter) Helper() {
+	a.t.Helper()
+}
+
+// Error reports a test failure through the wrapped test handler.
+func (a *Asserter) Error(args ...interface{}) {
+	a.t.Helper()
+	a.t.Error(args...)
+}
+
+// Errorf is like Error with a formatted message.
+func (a *Asserter) Errorf(format string, args ...interface{}) {
+	a.t.Helper()
+	a.t.Errorf(format, args...)
+}
+
+// Fatal reports a test failure and stops the test through the wrapped test handler.
+func (a *Asserter) Fatal(args ...interface{}) {
+	a.t.Helper()
+	a.t.Fatal(args...)
+}
+
+// Fatalf is like Fatal with a formatted message.
+func (a *Asserter) Fatalf(format string, args ...interface{}) {
+	a.t.Helper()
+	a.t.Fatalf(format, args...)
+}
+
+// Logf logs a formatted message through the wrapped test handler.
+func (a *Asserter) Logf(format string, args ...interface{}) {
+	a.t.Helper()
+	a.t.Logf(format, args...)
+}
+
+// Name returns the name of the test.
+func (a *Asserter) Name() string {
+	return a.t.Name()
+}
+
+// Cleanup registers fn to run when the test finishes.
+func (a *Asserter) Cleanup(fn func()) {
+	a.t.Cleanup(fn)
+}
+
+// Skip skips the test through the wrapped test handler.
+func (a *Asserter) Skip(args ...interface{}) {
+	a.t.Helper()
+	a.t.Skip(args...)
+}
+
+// Unwrap returns the wrapped test handler.
+func (a *Asserter) Unwrap() internal.T {
+	return a.t
+}
+
+// WithVerbosity returns a copy of the Asserter that prints values in
+// failure messages with the given verbosity.
+func (a *Asserter) WithVerbosity(v Verbosity) *Asserter {
+	c := *a
+	c.verbosity = &v
+	return &c
+}
+
+// WithColor returns a copy of the Asserter whose failure messages are
+// colored according to mode, see Settings.Color.
+func (a *Asserter) WithColor(mode ColorMode) *Asserter {
+	c := *a
+	c.color = &mode
+	return &c
+}
+
+// WithStackTrace returns a copy of the Asserter that appends, or does not
+// append, the stack of the failing assertion to failure messages.
+func (a *Asserter) WithStackTrace(enabled bool) *Asserter {
+	c := *a
+	c.stackTrace = &enabled
+	return &c
+}
+
+// WithContinueChains returns a copy of the Asserter whose chains of checks,
+// or not, keep running after one failed, see Settings.ContinueChains.
+func (a *Asserter) WithContinueChains(enabled bool) *Asserter {
+	c := *a
+	c.continueChains = &enabled
+	return &c
+}
+
+// WithLogPasses returns a copy of the Asserter that logs, or does not log,
+// passing assertions, see Settings.LogPasses.
+func (a *Asserter) WithLogPasses(enabled bool) *Asserter {
+	c := *a
+	c.logPasses = &enabled
+	return &c
+}
+
+// WithFailFast returns a copy of the Asserter whose failing assertions stop,
+// or do not stop, the test, see Settings.FailFast.
+func (a *Asserter) WithFailFast(enabled bool) *Asserter {
+	c := *a
+	c.failFast = &enabled
+	return &c
+}
+
+// WithCallerLocation returns a copy of the Asserter that prefixes, or does
+// not prefix, failure messages with the location of the assertion call site,
+// see Settings.CallerLocation.
+func (a *Asserter) WithCallerLocation(enabled bool) *Asserter {
+	c := *a
+	c.callerLocation = &enabled
+	return &c
+}
+
+// WithCmpOptions returns a copy of the Asserter whose deep comparisons use
+// github.com/google/go-cmp with the given options, see Settings.CmpOptions.
+//
+//	a := assert.New(t).WithCmpOptions(cmpopts.IgnoreUnexported(User{}))
+//	assert.That(a, got).Equal(expect)
+func (a *Asserter) WithCmpOptions(opts ...cmp.Option) *Asserter {
+	c := *a
+	c.cmpOptions = append([]cmp.Option{}, opts...)
+	return &c
+}
+
+// WithFuzzInput returns a copy of the Asserter that appends the given
+// inputs of a fuzz target to failure messages, as Go literals and, for
+// strings and byte slices, in hex, so that failures found by go test -fuzz
+// are reproducible from the test output alone:
+//
+//	f.Fuzz(func(t *testing.T, s string) {
+//		a := assert.New(t).WithFuzzInput(s)
+//		assert.ThatError(a, Validate(s)).IsNil()
+//	})
+func (a *Asserter) WithFuzzInput(inputs ...interface{}) *Asserter {
+	c := *a
+	c.fuzzInput = append([]interface{}{}, inputs...)
+	return &c
+}
+
+// WithReporter returns a copy of the Asserter whose failures are also sent
+// to r, in addition to the package-level Settings.Reporters.
+func (a *Asserter) WithReporter(r Reporter) *Asserter {
+	c := *a
+	c.reporters = append(append([]Reporter{}, a.reporters...), r)
+	return &c
+}
+
+// WithContext returns a copy of the Asserter that prefixes failure messages
+// with a description of the scenario, formatted as with fmt.Sprintf.
+// Contexts nest: those of an Asserter and of the Asserters it wraps are
+// joined with ": ", outermost first.
+//
+//	a := assert.New(t).WithContext("creating user %q", name)
+//	assert.ThatError(a, err).IsNil()
+func (a *Asserter) WithContext(format string, args ...interface{}) *Asserter {
+	c := *a
+	c.context = append(a.context[:len(a.context):len(a.context)], fmt.Sprintf(format, args...))
+	return &c
+}
+
+// Phase runs fn with an Asserter labeling the failures of its assertions
+// with label, so that the failures of large tests tell which logical phase
+// they come from. The labels of nested phases are indented under those of
+// their parents, and the failure under the innermost one:
+//
+//	assert.Phase(t, "parsing headers", func(g *assert.Asserter) {
+//		assert.Phase(g, "content type", func(g *assert.Asserter) {
+//			assert.ThatString(g, h.Get("Content-Type")).Equal("text/plain")
+//		})
+//	})
+//
+// reports
+//
+//	parsing headers:
+//	  content type:
+//	    strings not equal:
+//	    ...
+//
+// It is not named Group, as Group runs goroutines.
+func Phase(t internal.T, label string, fn func(g *Asserter)) {
+	t.Helper()
+	a := New(t)
+	a.phase = label
+	fn(a)
+}
+
+// phasesOf returns the labels of the phases of t, outermost first.
+func phasesOf(t internal.T) []string {
+	chain := asserterOf(t)
+	var phases []string
+	for i := len(chain) - 1; i >= 0; i-- {
+		if chain[i].phase != "" {
+			phases = append(phases, chain[i].phase)
+		}
+	}
+	return phases
+}
+
+// indentPhases returns the failure message str under the labels of its
+// phases, each indented by two spaces more than its parent.
+func indentPhases(phases []string, str string) string {
+	var b strings.Builder
+	for i, p := range phases {
+		b.WriteString(strings.Repeat("  ", i) + p + ":\n")
+	}
+	prefix := strings.Repeat("  ", len(phases))
+	b.WriteString(prefix + strings.ReplaceAll(str, "\n", "\n"+prefix))
+	return b.String()
+}
+
+// contextOf returns the contexts of the Asserters wrapping t, outermost first.
+func contextOf(t internal.T) []string {
+	chain := asserterOf(t)
+	var context []string
+	for i := len(chain) - 1; i >= 0; i-- {
+		context = append(context, chain[i].context...)
+	}
+	return context
+}
+
+// asserterOf returns the Asserters wrapping t, outermost first.
+func asserterOf(t internal.T) []*Asserter {
+	var chain []*Asserter
+	for {
+		switch w := t.(type) {
+		case *Asserter:
+			chain = append(chain, w)
+			t = w.t
+		case *negatedT:
+			t = w.t
+		case *chainT:
+			t = w.T
+		default:
+			return chain
+		}
+	}
+}
+
+// findT looks for an implementation of I in t and the test handlers it
+// wraps, which lets optional methods such as TempDir of the underlying
+// *testing.T be used through an Asserter.
+func findT[I any](t internal.T) (I, bool) {
+	for {
+		if i, ok := t.(I); ok {
+			return i, true
+		}
+		u, ok := t.(interface{ Unwrap() internal.T })
+		if !ok {
+			var zero I
+			return zero, false
+		}
+		t = u.Unwrap()
+	}
+}
@@ -0,0 +1,89 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert_test
+
+import (
+	"testing"
+
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/internal/mock"
+)
+
+func TestAsserter_WithContext(t *testing.T) {
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`creating user "bob": strings not equal:
+    got: (string) "a"
+ expect: (string) "b"
+message: name`})
+		a := assert.New(g).WithContext("creating user %q", "bob")
+		assert.ThatString(a, "a").Equal("b", "name")
+	})
+
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`case 1: creating user "bob": setting password: got false but expect true`})
+		outer := assert.New(g).WithContext("case %d", 1)
+		a := assert.New(outer).WithContext("creating user %q", "bob")
+		assert.True(a.WithContext("setting password"), false)
+	})
+
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`step: got true but expect false`})
+		base := assert.New(g).WithContext("step")
+		_ = base.WithContext("first")
+		assert.False(base, true)
+	})
+}
+
+func TestAsserter_TestingTB(t *testing.T) {
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Errorf("%d errors", 2)
+		g.EXPECT().Skip("requires docker")
+		a := assert.New(g).WithContext("step")
+		a.Errorf("%d errors", 2)
+		a.Skip("requires docker")
+	})
+}
+
+func TestPhase(t *testing.T) {
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`parsing headers:
+  content type:
+    got false but expect true`})
+		g.EXPECT().Error([]interface{}{`parsing headers:
+  body:
+    strings not equal:
+        got: (string) "a"
+     expect: (string) "b"`})
+		assert.Phase(g, "parsing headers", func(g *assert.Asserter) {
+			assert.True(g, true)
+			assert.Phase(g, "content type", func(g *assert.Asserter) {
+				assert.True(g, false)
+			})
+			assert.Phase(g, "body", func(g *assert.Asserter) {
+				assert.ThatString(g, "a").Equal("b")
+			})
+		})
+	})
+	runCase(t, func(g *mock.MockT) {
+		// contexts are prefixed to the failure under the phases
+		g.EXPECT().Error([]interface{}{`login:
+  user "bob": got true but expect false`})
+		assert.Phase(g, "login", func(g *assert.Asserter) {
+			assert.False(g.WithContext("user %q", "bob"), true)
+		})
+	})
+}
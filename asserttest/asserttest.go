@@ -0,0 +1,186 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package asserttest provides RecordingT, a test handler recording the
+// failures reported through it, so that matchers and helpers built on top
+// of package assert can have their failure messages unit-tested without a
+// mock:
+//
+//	rec := asserttest.Record(func(t *asserttest.RecordingT) {
+//		IsEven(t, 3)
+//	})
+//	assert.ThatSlice(t, rec.Messages()).Equal([]string{"got 3 but expect an even number"})
+package asserttest
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+)
+
+// RecordingT is a test handler recording the failures, logs and skips
+// reported through it. Failure messages are never colored. Fatal and Skip
+// stop the goroutine of Record, as they stop a test. It is safe for
+// concurrent use.
+type RecordingT struct {
+	mu       sync.Mutex
+	name     string
+	messages []string
+	logs     []string
+	failed   bool
+	stopped  bool
+	skipped  bool
+	cleanups []func()
+}
+
+// Record runs fn with a new RecordingT in its own goroutine, runs the
+// cleanup functions registered through it in last-in first-out order, and
+// returns it once done.
+func Record(fn func(t *RecordingT)) *RecordingT {
+	return RecordNamed("", fn)
+}
+
+// RecordNamed is like Record with a RecordingT whose Name method returns
+// name, for assertions keyed by test name such as snapshots.
+func RecordNamed(name string, fn func(t *RecordingT)) *RecordingT {
+	t := &RecordingT{name: name}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		defer t.runCleanups()
+		fn(t)
+	}()
+	<-done
+	return t
+}
+
+// runCleanups runs the registered cleanup functions, last first.
+func (t *RecordingT) runCleanups() {
+	for {
+		t.mu.Lock()
+		n := len(t.cleanups)
+		if n == 0 {
+			t.mu.Unlock()
+			return
+		}
+		fn := t.cleanups[n-1]
+		t.cleanups = t.cleanups[:n-1]
+		t.mu.Unlock()
+		fn()
+	}
+}
+
+// Messages returns the messages of the failures reported so far.
+func (t *RecordingT) Messages() []string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return append([]string(nil), t.messages...)
+}
+
+// Logs returns the messages logged so far.
+func (t *RecordingT) Logs() []string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return append([]string(nil), t.logs...)
+}
+
+// Failed reports whether a failure was reported.
+func (t *RecordingT) Failed() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.failed
+}
+
+// Stopped reports whether Fatal, Fatalf or Skip stopped the recording.
+func (t *RecordingT) Stopped() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.stopped
+}
+
+// Skipped reports whether Skip was called.
+func (t *RecordingT) Skipped() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.skipped
+}
+
+// Helper does nothing, there is no output to attribute.
+func (t *RecordingT) Helper() {}
+
+// Error records a failure.
+func (t *RecordingT) Error(args ...interface{}) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.failed = true
+	t.messages = append(t.messages, fmt.Sprint(args...))
+}
+
+// Errorf is like Error with a formatted message.
+func (t *RecordingT) Errorf(format string, args ...interface{}) {
+	t.Error(fmt.Sprintf(format, args...))
+}
+
+// Fatal records a failure and stops the goroutine of Record.
+func (t *RecordingT) Fatal(args ...interface{}) {
+	t.Error(args...)
+	t.stop()
+}
+
+// Fatalf is like Fatal with a formatted message.
+func (t *RecordingT) Fatalf(format string, args ...interface{}) {
+	t.Fatal(fmt.Sprintf(format, args...))
+}
+
+// Skip records a skip with its message as a log and stops the goroutine
+// of Record.
+func (t *RecordingT) Skip(args ...interface{}) {
+	t.mu.Lock()
+	t.skipped = true
+	t.logs = append(t.logs, fmt.Sprint(args...))
+	t.mu.Unlock()
+	t.stop()
+}
+
+// stop stops the goroutine of Record, as t.FailNow does.
+func (t *RecordingT) stop() {
+	t.mu.Lock()
+	t.stopped = true
+	t.mu.Unlock()
+	runtime.Goexit()
+}
+
+// Logf records a log message.
+func (t *RecordingT) Logf(format string, args ...interface{}) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.logs = append(t.logs, fmt.Sprintf(format, args...))
+}
+
+// Name returns the name given to RecordNamed.
+func (t *RecordingT) Name() string {
+	return t.name
+}
+
+// Cleanup registers fn to run when Record returns.
+func (t *RecordingT) Cleanup(fn func()) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.cleanups = append(t.cleanups, fn)
+}
+
+// Plain marks failure messages as never colored.
+func (t *RecordingT) Plain() {}
@@ -0,0 +1,67 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package asserttest_test
+
+import (
+	"testing"
+
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/asserttest"
+)
+
+// isEven is a helper built on top of package assert.
+func isEven(t assert.T, n int) {
+	t.Helper()
+	if n%2 != 0 {
+		assert.Fail(t, "got %d but expect an even number", n)
+	}
+}
+
+func TestRecord(t *testing.T) {
+	rec := asserttest.Record(func(t *asserttest.RecordingT) {
+		isEven(t, 2)
+	})
+	assert.False(t, rec.Failed())
+	assert.ThatNumber(t, len(rec.Messages())).Equal(0)
+
+	var cleanups []string
+	rec = asserttest.Record(func(t *asserttest.RecordingT) {
+		t.Cleanup(func() { cleanups = append(cleanups, "first") })
+		t.Cleanup(func() { cleanups = append(cleanups, "second") })
+		isEven(t, 3)
+		assert.ThatString(assert.Must(t), "a").Equal("b")
+		isEven(t, 5)
+	})
+	assert.True(t, rec.Failed())
+	assert.True(t, rec.Stopped())
+	assert.ThatSlice(t, rec.Messages()).Equal([]string{
+		"got 3 but expect an even number",
+		"strings not equal:\n    got: (string) \"a\"\n expect: (string) \"b\"",
+	})
+	assert.ThatSlice(t, cleanups).Equal([]string{"second", "first"})
+}
+
+func TestRecord_Skip(t *testing.T) {
+	rec := asserttest.RecordNamed("TestDocker", func(t *asserttest.RecordingT) {
+		assert.ThatString(t, t.Name()).Equal("TestDocker")
+		assert.SkipUnless(t, false, "requires docker")
+		t.Error("not skipped")
+	})
+	assert.True(t, rec.Skipped())
+	assert.False(t, rec.Failed())
+	assert.ThatSlice(t, rec.Logs()).Equal([]string{"requires docker"})
+}
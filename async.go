@@ -0,0 +1,73 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/lvan100/go-assert/internal"
+)
+
+// holds evaluates cond immediately and then every interval until duration
+// has elapsed, stopping early as soon as cond returns false. It returns how
+// long after the start cond returned false, and whether it always held.
+func holds(cond func() bool, duration, interval time.Duration) (time.Duration, bool) {
+	start := time.Now()
+	deadline := time.NewTimer(duration)
+	defer deadline.Stop()
+	tick := time.NewTicker(interval)
+	defer tick.Stop()
+	for {
+		if !cond() {
+			return time.Since(start).Round(time.Millisecond), false
+		}
+		select {
+		case <-deadline.C:
+			return 0, true
+		case <-tick.C:
+		}
+	}
+}
+
+// Never asserts that cond stays false for the whole duration, checking it
+// immediately and then every interval, e.g. to verify that a background
+// worker does not fire. It fails as soon as cond returns true.
+func Never(t internal.T, cond func() bool, duration, interval time.Duration, msg ...interface{}) bool {
+	t.Helper()
+	defer track(t, nil)()
+	if after, ok := holds(func() bool { return !cond() }, duration, interval); !ok {
+		str := fmt.Sprintf("condition became true after %v but expect it to stay false for %v", after, duration)
+		fail(t, str, msg...)
+		return false
+	}
+	return true
+}
+
+// Consistently asserts that cond stays true for the whole duration,
+// checking it immediately and then every interval. It fails as soon as cond
+// returns false.
+func Consistently(t internal.T, cond func() bool, duration, interval time.Duration, msg ...interface{}) bool {
+	t.Helper()
+	defer track(t, nil)()
+	if after, ok := holds(cond, duration, interval); !ok {
+		str := fmt.Sprintf("condition became false after %v but expect it to stay true for %v", after, duration)
+		fail(t, str, msg...)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,59 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert_test
+
+import (
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/internal/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestNever(t *testing.T) {
+	runCase(t, func(g *mock.MockT) {
+		var fired atomic.Bool
+		assert.Never(g, fired.Load, 30*time.Millisecond, 5*time.Millisecond)
+	})
+
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error(gomock.Cond(func(args []interface{}) bool {
+			str := args[0].(string)
+			return strings.HasPrefix(str, "condition became true after ") &&
+				strings.HasSuffix(str, " but expect it to stay false for 1m0s\nmessage: worker")
+		}))
+		var fired atomic.Bool
+		time.AfterFunc(10*time.Millisecond, func() { fired.Store(true) })
+		start := time.Now()
+		assert.Never(g, fired.Load, time.Minute, time.Millisecond, "worker")
+		assert.ThatNumber(t, time.Since(start)).LessThan(time.Second)
+	})
+}
+
+func TestConsistently(t *testing.T) {
+	runCase(t, func(g *mock.MockT) {
+		assert.Consistently(g, func() bool { return true }, 30*time.Millisecond, 5*time.Millisecond)
+	})
+
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"condition became false after 0s but expect it to stay true for 1m0s"})
+		assert.Consistently(g, func() bool { return false }, time.Minute, time.Millisecond)
+	})
+}
@@ -0,0 +1,110 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package benchassert offers assertions on the results of benchmarks, kept
+// apart from package assert so that package check, which serves non-test
+// code, does not import testing.
+package benchassert
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lvan100/go-assert"
+)
+
+// BenchmarkAssertion encapsulates the result of a benchmark and a test
+// handler for making assertions on its per-op metrics.
+type BenchmarkAssertion struct {
+	t assert.T
+	r testing.BenchmarkResult
+}
+
+// That returns a BenchmarkAssertion for the given testing object and
+// benchmark result, e.g. as returned by testing.Benchmark, which gates
+// performance in ordinary tests:
+//
+//	r := testing.Benchmark(BenchmarkEncode)
+//	benchassert.That(t, r).OpUnder(200 * time.Nanosecond).AllocsPerOpAtMost(1)
+func That(t assert.T, r testing.BenchmarkResult) *BenchmarkAssertion {
+	return &BenchmarkAssertion{
+		t: assert.Chain(t),
+		r: r,
+	}
+}
+
+// OpUnder asserts that the benchmark took less than d per op.
+func (a *BenchmarkAssertion) OpUnder(d time.Duration, msg ...interface{}) *BenchmarkAssertion {
+	a.t.Helper()
+	assert.Check(a.t, func() assert.Result {
+		if got := time.Duration(a.r.NsPerOp()); got >= d {
+			return assert.ResultFailure(`time per op is too long:
+    got: %v/op over %d ops
+ expect: under %v/op`, got, a.r.N, d)
+		}
+		return assert.ResultSuccess()
+	}, msg...)
+	return a
+}
+
+// AllocsPerOpAtMost asserts that the benchmark allocated at most n times
+// per op.
+func (a *BenchmarkAssertion) AllocsPerOpAtMost(n int64, msg ...interface{}) *BenchmarkAssertion {
+	a.t.Helper()
+	assert.Check(a.t, func() assert.Result {
+		if got := a.r.AllocsPerOp(); got > n {
+			return assert.ResultFailure(`too many allocations per op:
+    got: %d allocs/op over %d ops
+ expect: at most %d allocs/op`, got, a.r.N, n)
+		}
+		return assert.ResultSuccess()
+	}, msg...)
+	return a
+}
+
+// BytesPerOpAtMost asserts that the benchmark allocated at most n bytes per
+// op.
+func (a *BenchmarkAssertion) BytesPerOpAtMost(n int64, msg ...interface{}) *BenchmarkAssertion {
+	a.t.Helper()
+	assert.Check(a.t, func() assert.Result {
+		if got := a.r.AllocedBytesPerOp(); got > n {
+			return assert.ResultFailure(`too many bytes allocated per op:
+    got: %d B/op over %d ops
+ expect: at most %d B/op`, got, a.r.N, n)
+		}
+		return assert.ResultSuccess()
+	}, msg...)
+	return a
+}
+
+// OpUnder asserts, after the loop of a benchmark, that the loop took less
+// than d per op, which turns a benchmark into a lightweight performance
+// gate of go test -bench runs:
+//
+//	func BenchmarkEncode(b *testing.B) {
+//		for b.Loop() {
+//			enc.Encode(v)
+//		}
+//		benchassert.OpUnder(b, 200*time.Nanosecond)
+//	}
+//
+// Use it with b.Loop, whose benchmark function runs once: a benchmark
+// looping b.N times runs several times, and each run is checked, including
+// the first short run calibrating b.N.
+func OpUnder(b *testing.B, d time.Duration, msg ...interface{}) {
+	b.Helper()
+	That(b, testing.BenchmarkResult{N: b.N, T: b.Elapsed()}).OpUnder(d, msg...)
+}
@@ -0,0 +1,78 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package benchassert_test
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/benchassert"
+	"github.com/lvan100/go-assert/internal/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestMain(m *testing.M) {
+	assert.Configure(func(s *assert.Settings) {
+		s.Color = assert.ColorNever
+	})
+	os.Exit(m.Run())
+}
+
+func runCase(t *testing.T, f func(g *mock.MockT)) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	g := mock.NewMockT(ctrl)
+	g.EXPECT().Helper().AnyTimes()
+	f(g)
+}
+
+var sink []byte
+
+func TestThat(t *testing.T) {
+	r := testing.BenchmarkResult{N: 1000, T: 300 * time.Microsecond, MemAllocs: 2000, MemBytes: 64000}
+	runCase(t, func(g *mock.MockT) {
+		benchassert.That(g, r).OpUnder(time.Microsecond).AllocsPerOpAtMost(2).BytesPerOpAtMost(64)
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`time per op is too long:
+    got: 300ns/op over 1000 ops
+ expect: under 200ns/op`})
+		benchassert.That(g, r).OpUnder(200 * time.Nanosecond)
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`too many allocations per op:
+    got: 2 allocs/op over 1000 ops
+ expect: at most 1 allocs/op
+message: encode`})
+		benchassert.That(g, r).AllocsPerOpAtMost(1, "encode")
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`too many bytes allocated per op:
+    got: 64 B/op over 1000 ops
+ expect: at most 32 B/op`})
+		benchassert.That(g, r).BytesPerOpAtMost(32)
+	})
+}
+
+func BenchmarkOpUnder(b *testing.B) {
+	for b.Loop() {
+		sink = make([]byte, 64)
+	}
+	benchassert.OpUnder(b, time.Second)
+}
@@ -0,0 +1,76 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"fmt"
+
+	"github.com/lvan100/go-assert/internal"
+)
+
+// BoolAssertion encapsulates a boolean value and a test handler for making
+// assertions on it, bringing boolean checks into the fluent API.
+type BoolAssertion struct {
+	t   internal.T
+	v   bool
+	not bool
+}
+
+// ThatBool returns a BoolAssertion for the given testing object and value.
+func ThatBool(t internal.T, v bool) *BoolAssertion {
+	return &BoolAssertion{
+		t: chain(t),
+		v: v,
+	}
+}
+
+// Not returns a BoolAssertion over the same value whose assertions expect
+// the opposite, so that Not().IsTrue() passes only for false.
+func (a *BoolAssertion) Not() *BoolAssertion {
+	return &BoolAssertion{
+		t:   a.t,
+		v:   a.v,
+		not: !a.not,
+	}
+}
+
+// is reports a test failure unless the value is expect, inverted by Not.
+func (a *BoolAssertion) is(expect bool, msg ...interface{}) *BoolAssertion {
+	a.t.Helper()
+	if (a.v == expect) == a.not {
+		str := fmt.Sprintf("got %v but expect %v", a.v, expect)
+		if a.not {
+			str = fmt.Sprintf("got %v but expect not %v", a.v, expect)
+		}
+		fail(a.t, str, msg...)
+	}
+	return a
+}
+
+// IsTrue reports a test failure if the value is false.
+func (a *BoolAssertion) IsTrue(msg ...interface{}) *BoolAssertion {
+	a.t.Helper()
+	defer track(a.t, a.v)()
+	return a.is(true, msg...)
+}
+
+// IsFalse reports a test failure if the value is true.
+func (a *BoolAssertion) IsFalse(msg ...interface{}) *BoolAssertion {
+	a.t.Helper()
+	defer track(a.t, a.v)()
+	return a.is(false, msg...)
+}
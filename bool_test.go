@@ -0,0 +1,47 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert_test
+
+import (
+	"testing"
+
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/internal/mock"
+)
+
+func TestBool(t *testing.T) {
+	runCase(t, func(g *mock.MockT) {
+		assert.ThatBool(g, true).IsTrue().Not().IsFalse()
+		assert.ThatBool(g, false).IsFalse().Not().IsTrue()
+		assert.ThatBool(g, true).Not().Not().IsTrue()
+	})
+
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"got false but expect true\nmessage: ready"})
+		g.EXPECT().Error([]interface{}{"got true but expect false"})
+		g.EXPECT().Error([]interface{}{"got true but expect not true"})
+		assert.ThatBool(g, false).IsTrue("ready")
+		assert.ThatBool(g, true).IsFalse()
+		assert.ThatBool(g, true).Not().IsTrue()
+	})
+
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"enabling cache: got false but expect true"})
+		a := assert.New(g).WithContext("enabling cache")
+		assert.ThatBool(a, false).IsTrue()
+	})
+}
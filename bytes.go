@@ -0,0 +1,213 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/lvan100/go-assert/internal"
+)
+
+// hexDumpContext is the number of 16-byte rows shown before and after
+// the first mismatching row in a hex dump.
+const hexDumpContext = 1
+
+// BytesAssertion encapsulates a byte slice and a test handler for making
+// assertions on binary data. Failures are rendered as annotated hex dumps.
+type BytesAssertion struct {
+	t    internal.T
+	v    []byte
+	note string // appended to failure messages, see Decompressed
+}
+
+// ThatBytes returns a BytesAssertion for the given testing object and byte slice.
+func ThatBytes(t internal.T, v []byte) *BytesAssertion {
+	return &BytesAssertion{
+		t: chain(t),
+		v: v,
+	}
+}
+
+// Decompressed returns a BytesAssertion over the decoded content if the
+// bytes are compressed, detected from their magic number: gzip, or a format
+// registered with RegisterDecompressor. Failure messages then also report
+// the format and compressed size. Uncompressed bytes are asserted as they
+// are. A test failure is reported if the compressed bytes cannot be decoded.
+func (a *BytesAssertion) Decompressed(msg ...interface{}) *BytesAssertion {
+	a.t.Helper()
+	b, format, err := decompressBytes(a.v)
+	if err != nil {
+		str := fmt.Sprintf(`unable to decompress bytes:
+ format: %s
+  error: %v`, format, err)
+		fail(a.t, str, msg...)
+		return a
+	}
+	return &BytesAssertion{
+		t:    a.t,
+		v:    b,
+		note: compressionNote(format, int64(len(a.v))),
+	}
+}
+
+// Equal reports a test failure if the actual bytes are not equal to the expected bytes.
+func (a *BytesAssertion) Equal(expect []byte, msg ...interface{}) *BytesAssertion {
+	a.t.Helper()
+	defer track(a.t, a.v)()
+	if !bytes.Equal(a.v, expect) {
+		fail(a.t, bytesMismatch("bytes not equal", 0, a.v, expect)+a.note, msg...)
+	}
+	return a
+}
+
+// NotEqual reports a test failure if the actual bytes are equal to the given bytes.
+func (a *BytesAssertion) NotEqual(expect []byte, msg ...interface{}) *BytesAssertion {
+	a.t.Helper()
+	defer track(a.t, a.v)()
+	if bytes.Equal(a.v, expect) {
+		str := fmt.Sprintf(`bytes are equal:
+    got: length %d
+%s
+ expect: not equal`, len(a.v), hexDump(a.v, 0, -1))
+		fail(a.t, str+a.note, msg...)
+	}
+	return a
+}
+
+// EqualHex reports a test failure if the actual bytes are not equal to the
+// bytes encoded by the hexadecimal string. Whitespace in expect is ignored,
+// so dumps may be grouped for readability, e.g. "dead beef".
+func (a *BytesAssertion) EqualHex(expect string, msg ...interface{}) *BytesAssertion {
+	a.t.Helper()
+	defer track(a.t, a.v)()
+	b, err := hex.DecodeString(strings.Join(strings.Fields(expect), ""))
+	if err != nil {
+		str := fmt.Sprintf(`invalid hex in expect value:
+ expect: %q
+  error: %v`, expect, err)
+		fail(a.t, str+a.note, msg...)
+		return a
+	}
+	if !bytes.Equal(a.v, b) {
+		fail(a.t, bytesMismatch("bytes not equal", 0, a.v, b)+a.note, msg...)
+	}
+	return a
+}
+
+// HasLen reports a test failure if the length of the actual bytes is not equal to the expected length.
+func (a *BytesAssertion) HasLen(length int, msg ...interface{}) *BytesAssertion {
+	a.t.Helper()
+	defer track(a.t, a.v)()
+	if len(a.v) != length {
+		str := fmt.Sprintf(`length mismatch:
+    got: length %d
+ expect: length %d`, len(a.v), length)
+		fail(a.t, str+a.note, msg...)
+	}
+	return a
+}
+
+// ChunkAt reports a test failure if the bytes starting at offset are not equal to want.
+func (a *BytesAssertion) ChunkAt(offset int, want []byte, msg ...interface{}) *BytesAssertion {
+	a.t.Helper()
+	defer track(a.t, a.v)()
+	if offset < 0 || offset+len(want) > len(a.v) {
+		str := fmt.Sprintf(`chunk out of range:
+    got: length %d
+ expect: %d bytes at offset %d`, len(a.v), len(want), offset)
+		fail(a.t, str+a.note, msg...)
+		return a
+	}
+	if got := a.v[offset : offset+len(want)]; !bytes.Equal(got, want) {
+		fail(a.t, bytesMismatch("chunk not equal", offset, got, want)+a.note, msg...)
+	}
+	return a
+}
+
+// bytesMismatch builds a failure message with hex dumps of got and expect
+// around their first difference. base is the offset of both slices within
+// the original data and is used for the dump addresses.
+func bytesMismatch(title string, base int, got, expect []byte) string {
+	i := 0
+	for i < len(got) && i < len(expect) && got[i] == expect[i] {
+		i++
+	}
+	return fmt.Sprintf(`%s:
+ offset: %d (0x%x)
+    got: length %d
+%s
+ expect: length %d
+%s`, title, base+i, base+i, len(got), hexDump(got, base, i), len(expect), hexDump(expect, base, i))
+}
+
+// hexDump renders b in the classic "offset  hex  |ascii|" layout. When mark
+// is a valid index, only the rows around it are printed and the marked byte
+// is underlined with "^^". A negative mark dumps everything.
+func hexDump(b []byte, base int, mark int) string {
+	if len(b) == 0 {
+		return "        (empty)"
+	}
+	first, last := 0, (len(b)-1)/16
+	markRow := -1
+	if mark >= 0 {
+		markRow = min(mark, len(b)) / 16
+		first = max(markRow-hexDumpContext, 0)
+		last = min(markRow+hexDumpContext, last)
+	}
+	var sb strings.Builder
+	if first > 0 {
+		sb.WriteString("        ...\n")
+	}
+	for row := first; row <= last; row++ {
+		start := row * 16
+		end := min(start+16, len(b))
+		fmt.Fprintf(&sb, "%08x ", base+start)
+		for j := start; j < start+16; j++ {
+			if j == start+8 {
+				sb.WriteByte(' ')
+			}
+			if j < end {
+				fmt.Fprintf(&sb, " %02x", b[j])
+			} else {
+				sb.WriteString("   ")
+			}
+		}
+		sb.WriteString("  |")
+		for _, c := range b[start:end] {
+			if c < 0x20 || c > 0x7e {
+				c = '.'
+			}
+			sb.WriteByte(c)
+		}
+		sb.WriteString("|\n")
+		if row == markRow && mark < len(b) {
+			col := mark - start
+			pad := 10 + col*3
+			if col >= 8 {
+				pad++
+			}
+			sb.WriteString(strings.Repeat(" ", pad) + "^^\n")
+		}
+	}
+	if last < (len(b)-1)/16 {
+		sb.WriteString("        ...\n")
+	}
+	return strings.TrimSuffix(sb.String(), "\n")
+}
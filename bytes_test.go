@@ -0,0 +1,105 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert_test
+
+import (
+	"testing"
+
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/internal/mock"
+)
+
+func TestBytes_Equal(t *testing.T) {
+	runCase(t, func(g *mock.MockT) {
+		assert.ThatBytes(g, []byte{0xde, 0xad}).Equal([]byte{0xde, 0xad}).HasLen(2)
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`bytes not equal:
+ offset: 33 (0x21)
+    got: length 36
+        ...
+00000010  69 73 20 69 73 20 61 20  6c 6f 6e 67 65 72 20 70  |is is a longer p|
+00000020  61 79 00 01                                       |ay..|
+             ^^
+ expect: length 36
+        ...
+00000010  69 73 20 69 73 20 61 20  6c 6f 6e 67 65 72 20 70  |is is a longer p|
+00000020  61 59 00 01                                       |aY..|
+             ^^`})
+		assert.ThatBytes(g, []byte("hello, world! this is a longer pay\x00\x01")).
+			Equal([]byte("hello, world! this is a longer paY\x00\x01"))
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`bytes are equal:
+    got: length 2
+00000000  de ad                                             |..|
+ expect: not equal
+message: param (index=0)`})
+		assert.ThatBytes(g, []byte{0xde, 0xad}).NotEqual([]byte{0xde, 0xad}, "param (index=0)")
+	})
+}
+
+func TestBytes_EqualHex(t *testing.T) {
+	runCase(t, func(g *mock.MockT) {
+		assert.ThatBytes(g, []byte{0xde, 0xad, 0xbe, 0xef}).EqualHex("deadbeef").EqualHex("DE AD BE EF")
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`bytes not equal:
+ offset: 2 (0x2)
+    got: length 2
+00000000  de ad                                             |..|
+ expect: length 4
+00000000  de ad be ef                                       |....|
+                ^^`})
+		assert.ThatBytes(g, []byte{0xde, 0xad}).EqualHex("dead beef")
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`invalid hex in expect value:
+ expect: "xyz"
+  error: encoding/hex: invalid byte: U+0078 'x'`})
+		assert.ThatBytes(g, []byte{0xde, 0xad}).EqualHex("xyz")
+	})
+}
+
+func TestBytes_ChunkAt(t *testing.T) {
+	runCase(t, func(g *mock.MockT) {
+		assert.ThatBytes(g, []byte("\x89PNG\r\n")).ChunkAt(1, []byte("PNG"))
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`chunk not equal:
+ offset: 2 (0x2)
+    got: length 3
+00000001  50 4e 47                                          |PNG|
+             ^^
+ expect: length 3
+00000001  50 44 46                                          |PDF|
+             ^^`})
+		assert.ThatBytes(g, []byte("\x89PNG\r\n")).ChunkAt(1, []byte("PDF"))
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`chunk out of range:
+    got: length 6
+ expect: 3 bytes at offset 5`})
+		assert.ThatBytes(g, []byte("\x89PNG\r\n")).ChunkAt(5, []byte("PNG"))
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`length mismatch:
+    got: length 6
+ expect: length 8`})
+		assert.ThatBytes(g, []byte("\x89PNG\r\n")).HasLen(8)
+	})
+}
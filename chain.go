@@ -0,0 +1,125 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"sync/atomic"
+
+	"github.com/lvan100/go-assert/internal"
+)
+
+// chainT is the test handler of a chain of checks, such as a
+// StringAssertion. Once a check of the chain failed, the later ones are
+// meaningless and skipped, unless Settings.ContinueChains is set.
+type chainT struct {
+	internal.T
+	depth   atomic.Int32 // number of running checks of the chain
+	failing atomic.Bool  // a running check failed
+	failed  atomic.Bool  // a check failed, later ones are skipped
+}
+
+// chain returns the test handler of a new chain of checks made through t.
+// Assertions made through the handler of a chain, such as the Stdout of a
+// CmdAssertion, belong to the same chain.
+func chain(t internal.T) internal.T {
+	if c, ok := t.(*chainT); ok {
+		return c
+	}
+	return &chainT{T: t}
+}
+
+// Chain returns the test handler of a new chain of checks made through t,
+// for fluent assertions defined in other packages: once a check made
+// through the handler failed, the assertions of the later ones are skipped
+// as those of the fluent assertions of this package are, unless
+// Settings.ContinueChains is set. A check is typically a Check call:
+//
+//	func ThatQueue(t assert.T, q *Queue) *QueueAssertion {
+//		return &QueueAssertion{t: assert.Chain(t), q: q}
+//	}
+func Chain(t T) T {
+	return chain(t)
+}
+
+// begin starts a check of the chain and returns the function ending it.
+// The failures of a check are all reported, the chain only fails when
+// the check ends.
+func (c *chainT) begin() func() {
+	c.depth.Add(1)
+	return func() {
+		if c.depth.Add(-1) == 0 && c.failing.Load() {
+			c.failed.Store(true)
+		}
+	}
+}
+
+// fail notes a failure of the chain.
+func (c *chainT) fail() {
+	if c.depth.Load() == 0 {
+		c.failed.Store(true)
+		return
+	}
+	c.failing.Store(true)
+}
+
+// skipped reports whether the checks made through t are skipped, as a
+// previous check of their chain failed.
+func skipped(t internal.T) bool {
+	c, ok := findT[*chainT](t)
+	return ok && c.failed.Load() && !continueChainsOf(t)
+}
+
+// continueChainsOf reports whether the checks of a chain made through t
+// still run after one failed: the setting of the outermost Asserter
+// wrapping t that has one, or else Settings.ContinueChains.
+func continueChainsOf(t internal.T) bool {
+	for _, a := range asserterOf(t) {
+		if a.continueChains != nil {
+			return *a.continueChains
+		}
+	}
+	return currentSettings().ContinueChains
+}
+
+func (c *chainT) Error(args ...interface{}) {
+	c.T.Helper()
+	c.fail()
+	c.T.Error(args...)
+}
+
+func (c *chainT) Errorf(format string, args ...interface{}) {
+	c.T.Helper()
+	c.fail()
+	c.T.Errorf(format, args...)
+}
+
+func (c *chainT) Fatal(args ...interface{}) {
+	c.T.Helper()
+	c.fail()
+	c.T.Fatal(args...)
+}
+
+func (c *chainT) Fatalf(format string, args ...interface{}) {
+	c.T.Helper()
+	c.fail()
+	c.T.Fatalf(format, args...)
+}
+
+// Unwrap returns the wrapped test handler.
+func (c *chainT) Unwrap() internal.T {
+	return c.T
+}
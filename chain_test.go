@@ -0,0 +1,69 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert_test
+
+import (
+	"testing"
+
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/internal/mock"
+)
+
+func TestChain(t *testing.T) {
+	const (
+		noPrefix = "string does not start with the specified prefix:\n    got: (string) \"abc\"\n expect: to have prefix \"x\""
+		noSuffix = "string does not end with the specified suffix:\n    got: (string) \"abc\"\n expect: to have suffix \"z\""
+	)
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{noPrefix})
+		assert.ThatString(g, "abc").HasPrefix("x").Contains("y").HasSuffix("z")
+	})
+
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{noPrefix})
+		g.EXPECT().Error([]interface{}{noSuffix})
+		a := assert.New(g).WithContinueChains(true)
+		assert.ThatString(a, "abc").HasPrefix("x").HasSuffix("z")
+	})
+
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{noPrefix})
+		g.EXPECT().Error([]interface{}{noSuffix})
+		defer assert.Configure(func(s *assert.Settings) {
+			s.ContinueChains = true
+		})()
+		assert.ThatString(g, "abc").HasPrefix("x").HasSuffix("z")
+	})
+}
+
+func TestChain_Exported(t *testing.T) {
+	positive := func(n int) assert.Comparison {
+		return func() assert.Result {
+			if n <= 0 {
+				return assert.ResultFailure("got %d but expect positive", n)
+			}
+			return assert.ResultSuccess()
+		}
+	}
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"got 0 but expect positive"})
+		c := assert.Chain(g)
+		assert.True(t, assert.Check(c, positive(1)))
+		assert.False(t, assert.Check(c, positive(0)))
+		assert.Check(c, positive(-1)) // skipped
+	})
+}
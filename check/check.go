@@ -0,0 +1,160 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+// Package check offers the assertions of package assert as functions and
+// methods returning an error instead of failing a test, so that the same
+// matchers can be reused in validation code, retry loops and polling:
+//
+//	err := check.That(resp.Status).Equal("ready")
+//
+// The returned error carries the failure message the assertion would have
+// reported, never colored, and is nil if the check passes.
+package check
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/internal"
+)
+
+// stop is the panic value unwinding a check stopped by Fatal.
+type stop struct{}
+
+// recorder is a test handler recording the failure messages of a check.
+type recorder struct {
+	msgs     []string
+	cleanups []func()
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Error(args ...interface{}) {
+	r.msgs = append(r.msgs, fmt.Sprint(args...))
+}
+
+func (r *recorder) Errorf(format string, args ...interface{}) {
+	r.Error(fmt.Sprintf(format, args...))
+}
+
+func (r *recorder) Fatal(args ...interface{}) {
+	r.Error(args...)
+	panic(stop{})
+}
+
+func (r *recorder) Fatalf(format string, args ...interface{}) {
+	r.Fatal(fmt.Sprintf(format, args...))
+}
+
+func (r *recorder) Logf(format string, args ...interface{}) {}
+
+func (r *recorder) Name() string {
+	return ""
+}
+
+// Cleanup registers fn to be called when the check returns, so that the
+// per-test state of its assertions does not outlive it.
+func (r *recorder) Cleanup(fn func()) {
+	r.cleanups = append(r.cleanups, fn)
+}
+
+// Skip stops the check without failing it.
+func (r *recorder) Skip(args ...interface{}) {
+	panic(stop{})
+}
+
+// cleanup calls the functions registered with Cleanup in reverse order.
+func (r *recorder) cleanup() {
+	for i := len(r.cleanups) - 1; i >= 0; i-- {
+		r.cleanups[i]()
+	}
+}
+
+// Plain implements internal.Plain.
+func (r *recorder) Plain() {}
+
+// Collected implements internal.Collected, as the failures recorded are
+// returned as errors.
+func (r *recorder) Collected() {}
+
+// run runs the assertions of fn and returns their failures as an error.
+func run(fn func(t internal.T)) (err error) {
+	r := new(recorder)
+	defer r.cleanup()
+	defer func() {
+		if p := recover(); p != nil {
+			if _, ok := p.(stop); !ok {
+				panic(p)
+			}
+		}
+		if len(r.msgs) > 0 {
+			err = errors.New(strings.Join(r.msgs, "\n"))
+		}
+	}()
+	fn(r)
+	return nil
+}
+
+// Run runs the assertions of fn and returns their failures as an error, so
+// that any assertion of package assert, including those check has no
+// counterpart of, can check invariants outside of tests:
+//
+//	err := check.Run(func(t assert.T) {
+//		assert.ThatOrdered(t, cfg.Port).Between(1, 65535)
+//		assert.ThatString(t, cfg.Host).Not().IsEmpty()
+//	})
+//
+// Assertions after a failing one still run unless fail-fast is set.
+func Run(fn func(t assert.T)) error {
+	return run(fn)
+}
+
+// True checks that got is true. It returns an error if the value is false.
+func True(got bool) error {
+	return run(func(t internal.T) {
+		assert.True(t, got)
+	})
+}
+
+// False checks that got is false. It returns an error if the value is true.
+func False(got bool) error {
+	return run(func(t internal.T) {
+		assert.False(t, got)
+	})
+}
+
+// Nil checks that got is nil. It returns an error if the value is not nil.
+func Nil(got interface{}) error {
+	return run(func(t internal.T) {
+		assert.Nil(t, got)
+	})
+}
+
+// NotNil checks that got is not nil. It returns an error if the value is nil.
+func NotNil(got interface{}) error {
+	return run(func(t internal.T) {
+		assert.NotNil(t, got)
+	})
+}
+
+// Panic checks that fn panics and the panic message matches expr.
+// It returns an error if fn does not panic or if the recovered message does not satisfy expr.
+func Panic(fn func(), expr string) error {
+	return run(func(t internal.T) {
+		assert.Panic(t, fn, expr)
+	})
+}
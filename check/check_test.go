@@ -0,0 +1,126 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package check_test
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/check"
+	"github.com/lvan100/go-assert/internal"
+)
+
+func TestCheck(t *testing.T) {
+	assert.Nil(t, check.That(3).Equal(3))
+	assert.Nil(t, check.ThatString("abc").HasPrefix("ab"))
+	assert.Nil(t, check.ThatNumber(5).Between(1, 10))
+	assert.Nil(t, check.ThatSlice([]int{1, 2}).Contains(2))
+	assert.Nil(t, check.ThatMap(map[string]int{"a": 1}).HasKeyValue("a", 1))
+	assert.Nil(t, check.ThatError(nil).IsNil())
+	assert.Nil(t, check.True(true))
+
+	err := check.That(3).Equal(4)
+	assert.ThatError(t, err).Matches(`^got \(int\) 3 but expect \(int\) 4$`)
+
+	err = check.ThatString("a\r\nb").IgnoringLineEndings().Equal("a\nb")
+	assert.Nil(t, err)
+
+	err = check.ThatNumber(0).IsPositive()
+	assert.ThatError(t, err).Matches(`^got \(int\) 0 but expect positive$`)
+
+	err = check.ThatError(errors.New("boom")).IsNil()
+	assert.ThatError(t, err).ContainsMessage("boom")
+}
+
+func TestCheck_Plain(t *testing.T) {
+	defer assert.Configure(func(s *assert.Settings) {
+		s.Color = assert.ColorAlways
+	})()
+	err := check.False(true)
+	assert.ThatError(t, err).Matches(`^got true but expect false$`)
+}
+
+func TestCheck_FailFast(t *testing.T) {
+	defer assert.SetFailFast(true)()
+	err := check.ThatString("abc").HasPrefix("x")
+	assert.ThatError(t, err).ContainsMessage("abc")
+}
+
+func TestCheck_Reporters(t *testing.T) {
+	var reported []assert.Failure
+	dir := t.TempDir()
+	defer assert.Configure(func(s *assert.Settings) {
+		s.Reporters = []assert.Reporter{assert.ReporterFunc(func(t internal.T, f assert.Failure) {
+			reported = append(reported, f)
+		})}
+		s.ArtifactsDir = dir
+		s.ArtifactThreshold = 1
+	})()
+	err := check.That([]int{1}).Equal([]int{2})
+	assert.ThatError(t, err).ContainsMessage("expect")
+	err = check.Run(func(t assert.T) {
+		assert.ThatString(t, "a").Equal("b")
+	})
+	assert.ThatError(t, err).ContainsMessage("strings not equal")
+	// the failures are returned, not reported
+	assert.That(t, reported).IsEmpty()
+	entries, err := os.ReadDir(dir)
+	assert.Nil(t, err)
+	assert.That(t, entries).IsEmpty()
+}
+
+func TestRun(t *testing.T) {
+	err := check.Run(func(t assert.T) {
+		assert.ThatOrdered(t, 8080).Between(1, 65535)
+		assert.ThatString(t, "localhost").Not().IsEmpty()
+	})
+	assert.Nil(t, err)
+
+	err = check.Run(func(t assert.T) {
+		assert.ThatOrdered(t, 0).Between(1, 65535)
+		assert.ThatString(t, "").Not().IsEmpty()
+	})
+	assert.ThatError(t, err).Matches(`^got \(int\) 0 but expect between \(int\) 1 and \(int\) 65535\nexpect StringAssertion.IsEmpty to fail:\n    got: \(string\) ""$`)
+}
+
+func TestRun_Cleanup(t *testing.T) {
+	var calls []string
+	err := check.Run(func(t assert.T) {
+		t.Cleanup(func() { calls = append(calls, "first") })
+		t.Cleanup(func() { calls = append(calls, "second") })
+		assert.That(t, calls).IsEmpty()
+		assert.ThatString(t, "a").Equal("b")
+	})
+	assert.ThatError(t, err).ContainsMessage("strings not equal")
+	assert.That(t, calls).Equal([]string{"second", "first"})
+}
+
+func TestRun_TestingTB(t *testing.T) {
+	err := check.Run(func(t assert.T) {
+		t.Errorf("%d errors", 2)
+	})
+	assert.ThatError(t, err).Matches(`^2 errors$`)
+
+	// a skipped check does not fail
+	err = check.Run(func(t assert.T) {
+		t.Skip("not applicable")
+		t.Error("not skipped")
+	})
+	assert.Nil(t, err)
+}
@@ -0,0 +1,45 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package check_test
+
+import (
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/lvan100/go-assert"
+)
+
+// TestDeps checks that check, meant for code outside of tests, links none
+// of the test-only packages.
+func TestDeps(t *testing.T) {
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("go command not found")
+	}
+	out, err := exec.Command("go", "list", "-deps", ".").Output()
+	assert.Nil(t, err)
+	deps := strings.Fields(string(out))
+	for _, pkg := range []string{
+		"testing",
+		"net/http/httptest",
+		"os/exec",
+		"go.uber.org/mock/gomock",
+		"gopkg.in/yaml.v3",
+	} {
+		assert.ThatSlice(t, deps).Not().Contains(pkg, "check depends on %s", pkg)
+	}
+}
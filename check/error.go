@@ -0,0 +1,88 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package check
+
+import (
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/internal"
+)
+
+// ErrorCheck wraps an error value for fluent checks.
+type ErrorCheck struct {
+	v error
+}
+
+// ThatError returns a ErrorCheck for an error value v.
+func ThatError(v error) *ErrorCheck {
+	return &ErrorCheck{v: v}
+}
+
+// assert returns the assertion of the check made through t.
+func (c *ErrorCheck) assert(t internal.T) *assert.ErrorAssertion {
+	return assert.ThatError(t, c.v)
+}
+
+// IsNil returns an error if the error is not nil.
+func (c *ErrorCheck) IsNil() error {
+	return run(func(t internal.T) {
+		c.assert(t).IsNil()
+	})
+}
+
+// IsNotNil returns an error if the error is nil.
+func (c *ErrorCheck) IsNotNil() error {
+	return run(func(t internal.T) {
+		c.assert(t).IsNotNil()
+	})
+}
+
+// Is returns an error if the error is not the same as the given error.
+func (c *ErrorCheck) Is(target error) error {
+	return run(func(t internal.T) {
+		c.assert(t).Is(target)
+	})
+}
+
+// IsNot returns an error if the error is the same as the given error.
+func (c *ErrorCheck) IsNot(target error) error {
+	return run(func(t internal.T) {
+		c.assert(t).IsNot(target)
+	})
+}
+
+// As checks if the error can be converted to the target type.
+func (c *ErrorCheck) As(target interface{}) error {
+	return run(func(t internal.T) {
+		c.assert(t).As(target)
+	})
+}
+
+// ContainsMessage returns an error if the error message does not contain the given substring.
+func (c *ErrorCheck) ContainsMessage(substring string) error {
+	return run(func(t internal.T) {
+		c.assert(t).ContainsMessage(substring)
+	})
+}
+
+// Matches returns an error if the error string does not match the given expression.
+// It expects a non-nil error and uses the provided expression (typically a regex)
+// to validate the error message content.
+func (c *ErrorCheck) Matches(expr string) error {
+	return run(func(t internal.T) {
+		c.assert(t).Matches(expr)
+	})
+}
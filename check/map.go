@@ -0,0 +1,163 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package check
+
+import (
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/internal"
+)
+
+// MapCheck wraps a map value for fluent checks.
+type MapCheck[K comparable, V comparable] struct {
+	v map[K]V
+}
+
+// ThatMap returns a MapCheck for a map value v.
+func ThatMap[K comparable, V comparable](v map[K]V) *MapCheck[K, V] {
+	return &MapCheck[K, V]{v: v}
+}
+
+// assert returns the assertion of the check made through t.
+func (c *MapCheck[K, V]) assert(t internal.T) *assert.MapAssertion[K, V] {
+	return assert.ThatMap(t, c.v)
+}
+
+// Len checks that the map has the expected length.
+func (c *MapCheck[K, V]) Len(length int) error {
+	return run(func(t internal.T) {
+		c.assert(t).Len(length)
+	})
+}
+
+// Empty checks that the map is empty.
+func (c *MapCheck[K, V]) Empty() error {
+	return run(func(t internal.T) {
+		c.assert(t).Empty()
+	})
+}
+
+// NotEmpty checks that the map is not empty.
+func (c *MapCheck[K, V]) NotEmpty() error {
+	return run(func(t internal.T) {
+		c.assert(t).NotEmpty()
+	})
+}
+
+// Equal checks that the map is equal to the expected map.
+func (c *MapCheck[K, V]) Equal(expect map[K]V) error {
+	return run(func(t internal.T) {
+		c.assert(t).Equal(expect)
+	})
+}
+
+// NotEqual checks that the map is not equal to the expected map.
+func (c *MapCheck[K, V]) NotEqual(expect map[K]V) error {
+	return run(func(t internal.T) {
+		c.assert(t).NotEqual(expect)
+	})
+}
+
+// Contains checks that the map contains the expected key.
+func (c *MapCheck[K, V]) Contains(key K) error {
+	return run(func(t internal.T) {
+		c.assert(t).Contains(key)
+	})
+}
+
+// NotContains checks that the map does not contain the expected key.
+func (c *MapCheck[K, V]) NotContains(key K) error {
+	return run(func(t internal.T) {
+		c.assert(t).NotContains(key)
+	})
+}
+
+// ContainsValue checks that the map contains the expected value.
+func (c *MapCheck[K, V]) ContainsValue(value V) error {
+	return run(func(t internal.T) {
+		c.assert(t).ContainsValue(value)
+	})
+}
+
+// NotContainsValue checks that the map does not contain the expected value.
+func (c *MapCheck[K, V]) NotContainsValue(value V) error {
+	return run(func(t internal.T) {
+		c.assert(t).NotContainsValue(value)
+	})
+}
+
+// HasKeyValue checks that the map contains the expected key-value pair.
+func (c *MapCheck[K, V]) HasKeyValue(key K, value V) error {
+	return run(func(t internal.T) {
+		c.assert(t).HasKeyValue(key, value)
+	})
+}
+
+// ContainsKeys checks that the map contains all the expected keys.
+func (c *MapCheck[K, V]) ContainsKeys(keys []K) error {
+	return run(func(t internal.T) {
+		c.assert(t).ContainsKeys(keys)
+	})
+}
+
+// NotContainsKeys checks that the map does not contain any of the expected keys.
+func (c *MapCheck[K, V]) NotContainsKeys(keys []K) error {
+	return run(func(t internal.T) {
+		c.assert(t).NotContainsKeys(keys)
+	})
+}
+
+// ContainsValues checks that the map contains all the expected values.
+func (c *MapCheck[K, V]) ContainsValues(values []V) error {
+	return run(func(t internal.T) {
+		c.assert(t).ContainsValues(values)
+	})
+}
+
+// NotContainsValues checks that the map does not contain any of the expected values.
+func (c *MapCheck[K, V]) NotContainsValues(values []V) error {
+	return run(func(t internal.T) {
+		c.assert(t).NotContainsValues(values)
+	})
+}
+
+// IsSubsetOf checks that the map is a subset of the expected map.
+func (c *MapCheck[K, V]) IsSubsetOf(expect map[K]V) error {
+	return run(func(t internal.T) {
+		c.assert(t).IsSubsetOf(expect)
+	})
+}
+
+// IsSupersetOf checks that the map is a superset of the expected map.
+func (c *MapCheck[K, V]) IsSupersetOf(expect map[K]V) error {
+	return run(func(t internal.T) {
+		c.assert(t).IsSupersetOf(expect)
+	})
+}
+
+// HasSameKeys checks that the map has the same keys as the expected map.
+func (c *MapCheck[K, V]) HasSameKeys(expect map[K]V) error {
+	return run(func(t internal.T) {
+		c.assert(t).HasSameKeys(expect)
+	})
+}
+
+// HasSameValues checks that the map has the same values as the expected map.
+func (c *MapCheck[K, V]) HasSameValues(expect map[K]V) error {
+	return run(func(t internal.T) {
+		c.assert(t).HasSameValues(expect)
+	})
+}
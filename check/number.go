@@ -0,0 +1,163 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package check
+
+import (
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/internal"
+)
+
+// NumberCheck wraps a number value for fluent checks.
+type NumberCheck[T assert.Number] struct {
+	v T
+}
+
+// ThatNumber returns a NumberCheck for a number value v.
+func ThatNumber[T assert.Number](v T) *NumberCheck[T] {
+	return &NumberCheck[T]{v: v}
+}
+
+// assert returns the assertion of the check made through t.
+func (c *NumberCheck[T]) assert(t internal.T) *assert.NumberAssertion[T] {
+	return assert.ThatNumber(t, c.v)
+}
+
+// Equal checks that the number value is equal to the expected value.
+func (c *NumberCheck[T]) Equal(expect T) error {
+	return run(func(t internal.T) {
+		c.assert(t).Equal(expect)
+	})
+}
+
+// NotEqual checks that the number value is not equal to the expected value.
+func (c *NumberCheck[T]) NotEqual(expect T) error {
+	return run(func(t internal.T) {
+		c.assert(t).NotEqual(expect)
+	})
+}
+
+// GreaterThan checks that the number value is greater than the expected value.
+func (c *NumberCheck[T]) GreaterThan(expect T) error {
+	return run(func(t internal.T) {
+		c.assert(t).GreaterThan(expect)
+	})
+}
+
+// GreaterOrEqual checks that the number value is greater than or equal to the expected value.
+func (c *NumberCheck[T]) GreaterOrEqual(expect T) error {
+	return run(func(t internal.T) {
+		c.assert(t).GreaterOrEqual(expect)
+	})
+}
+
+// LessThan checks that the number value is less than the expected value.
+func (c *NumberCheck[T]) LessThan(expect T) error {
+	return run(func(t internal.T) {
+		c.assert(t).LessThan(expect)
+	})
+}
+
+// LessOrEqual checks that the number value is less than or equal to the expected value.
+func (c *NumberCheck[T]) LessOrEqual(expect T) error {
+	return run(func(t internal.T) {
+		c.assert(t).LessOrEqual(expect)
+	})
+}
+
+// IsZero checks that the number value is zero.
+func (c *NumberCheck[T]) IsZero() error {
+	return run(func(t internal.T) {
+		c.assert(t).IsZero()
+	})
+}
+
+// NotZero checks that the number value is not zero.
+func (c *NumberCheck[T]) NotZero() error {
+	return run(func(t internal.T) {
+		c.assert(t).NotZero()
+	})
+}
+
+// IsPositive checks that the number value is positive.
+func (c *NumberCheck[T]) IsPositive() error {
+	return run(func(t internal.T) {
+		c.assert(t).IsPositive()
+	})
+}
+
+// IsNegative checks that the number value is negative.
+func (c *NumberCheck[T]) IsNegative() error {
+	return run(func(t internal.T) {
+		c.assert(t).IsNegative()
+	})
+}
+
+// IsNonNegative checks that the number value is non-negative.
+func (c *NumberCheck[T]) IsNonNegative() error {
+	return run(func(t internal.T) {
+		c.assert(t).IsNonNegative()
+	})
+}
+
+// IsNonPositive checks that the number value is non-positive.
+func (c *NumberCheck[T]) IsNonPositive() error {
+	return run(func(t internal.T) {
+		c.assert(t).IsNonPositive()
+	})
+}
+
+// Between checks that the number value is between the lower and upper bounds (inclusive).
+func (c *NumberCheck[T]) Between(lower, upper T) error {
+	return run(func(t internal.T) {
+		c.assert(t).Between(lower, upper)
+	})
+}
+
+// NotBetween checks that the number value is not between the lower and upper bounds (exclusive).
+func (c *NumberCheck[T]) NotBetween(lower, upper T) error {
+	return run(func(t internal.T) {
+		c.assert(t).NotBetween(lower, upper)
+	})
+}
+
+// InDelta checks that the number value is within the delta range of the expected value.
+func (c *NumberCheck[T]) InDelta(expect T, delta T) error {
+	return run(func(t internal.T) {
+		c.assert(t).InDelta(expect, delta)
+	})
+}
+
+// IsNaN checks that the number value is NaN (Not a Number).
+func (c *NumberCheck[T]) IsNaN() error {
+	return run(func(t internal.T) {
+		c.assert(t).IsNaN()
+	})
+}
+
+// IsInf checks that the number value is infinite.
+func (c *NumberCheck[T]) IsInf(sign int) error {
+	return run(func(t internal.T) {
+		c.assert(t).IsInf(sign)
+	})
+}
+
+// IsFinite checks that the number value is finite.
+func (c *NumberCheck[T]) IsFinite() error {
+	return run(func(t internal.T) {
+		c.assert(t).IsFinite()
+	})
+}
@@ -0,0 +1,221 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package check
+
+import (
+	"cmp"
+
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/internal"
+)
+
+// SliceCheck wraps a slice value for fluent checks.
+type SliceCheck[T cmp.Ordered] struct {
+	v []T
+}
+
+// ThatSlice returns a SliceCheck for a slice value v.
+func ThatSlice[T cmp.Ordered](v []T) *SliceCheck[T] {
+	return &SliceCheck[T]{v: v}
+}
+
+// assert returns the assertion of the check made through t.
+func (c *SliceCheck[T]) assert(t internal.T) *assert.SliceAssertion[T] {
+	return assert.ThatSlice(t, c.v)
+}
+
+// Len checks that the slice has the expected length.
+func (c *SliceCheck[T]) Len(length int) error {
+	return run(func(t internal.T) {
+		c.assert(t).Len(length)
+	})
+}
+
+// IsEmpty checks that the slice is empty.
+func (c *SliceCheck[T]) IsEmpty() error {
+	return run(func(t internal.T) {
+		c.assert(t).IsEmpty()
+	})
+}
+
+// IsNotEmpty checks that the slice is not empty.
+func (c *SliceCheck[T]) IsNotEmpty() error {
+	return run(func(t internal.T) {
+		c.assert(t).IsNotEmpty()
+	})
+}
+
+// IsNil checks that the slice is nil.
+func (c *SliceCheck[T]) IsNil() error {
+	return run(func(t internal.T) {
+		c.assert(t).IsNil()
+	})
+}
+
+// IsNotNil checks that the slice is not nil.
+func (c *SliceCheck[T]) IsNotNil() error {
+	return run(func(t internal.T) {
+		c.assert(t).IsNotNil()
+	})
+}
+
+// Zero checks that the slice is nil or empty.
+func (c *SliceCheck[T]) Zero() error {
+	return run(func(t internal.T) {
+		c.assert(t).Zero()
+	})
+}
+
+// NotZero checks that the slice is not nil and not empty.
+func (c *SliceCheck[T]) NotZero() error {
+	return run(func(t internal.T) {
+		c.assert(t).NotZero()
+	})
+}
+
+// Contains checks that the slice contains the expected element.
+func (c *SliceCheck[T]) Contains(element T) error {
+	return run(func(t internal.T) {
+		c.assert(t).Contains(element)
+	})
+}
+
+// NotContains checks that the slice does not contain the expected element.
+func (c *SliceCheck[T]) NotContains(element T) error {
+	return run(func(t internal.T) {
+		c.assert(t).NotContains(element)
+	})
+}
+
+// SubSlice checks that the slice contains the expected sub-slice.
+func (c *SliceCheck[T]) SubSlice(sub []T) error {
+	return run(func(t internal.T) {
+		c.assert(t).SubSlice(sub)
+	})
+}
+
+// NotSubSlice checks that the slice does not contain the expected sub-slice.
+func (c *SliceCheck[T]) NotSubSlice(sub []T) error {
+	return run(func(t internal.T) {
+		c.assert(t).NotSubSlice(sub)
+	})
+}
+
+// HasPrefix checks that the slice starts with the specified prefix.
+func (c *SliceCheck[T]) HasPrefix(prefix []T) error {
+	return run(func(t internal.T) {
+		c.assert(t).HasPrefix(prefix)
+	})
+}
+
+// HasSuffix checks that the slice ends with the specified suffix.
+func (c *SliceCheck[T]) HasSuffix(suffix []T) error {
+	return run(func(t internal.T) {
+		c.assert(t).HasSuffix(suffix)
+	})
+}
+
+// Equal checks that the slice is equal to the expected slice.
+func (c *SliceCheck[T]) Equal(expect []T) error {
+	return run(func(t internal.T) {
+		c.assert(t).Equal(expect)
+	})
+}
+
+// NotEqual checks that the slice is not equal to the expected slice.
+func (c *SliceCheck[T]) NotEqual(expect []T) error {
+	return run(func(t internal.T) {
+		c.assert(t).NotEqual(expect)
+	})
+}
+
+// IsIncreasing checks that the slice is strictly increasing.
+func (c *SliceCheck[T]) IsIncreasing() error {
+	return run(func(t internal.T) {
+		c.assert(t).IsIncreasing()
+	})
+}
+
+// IsNonIncreasing checks that the slice is not strictly increasing.
+func (c *SliceCheck[T]) IsNonIncreasing() error {
+	return run(func(t internal.T) {
+		c.assert(t).IsNonIncreasing()
+	})
+}
+
+// IsDecreasing checks that the slice is strictly decreasing.
+func (c *SliceCheck[T]) IsDecreasing() error {
+	return run(func(t internal.T) {
+		c.assert(t).IsDecreasing()
+	})
+}
+
+// IsNonDecreasing checks that the slice is not strictly decreasing.
+func (c *SliceCheck[T]) IsNonDecreasing() error {
+	return run(func(t internal.T) {
+		c.assert(t).IsNonDecreasing()
+	})
+}
+
+// IsSorted checks that the slice is sorted in ascending order.
+func (c *SliceCheck[T]) IsSorted() error {
+	return run(func(t internal.T) {
+		c.assert(t).IsSorted()
+	})
+}
+
+// IsSortedDescending checks that the slice is sorted in descending order.
+func (c *SliceCheck[T]) IsSortedDescending() error {
+	return run(func(t internal.T) {
+		c.assert(t).IsSortedDescending()
+	})
+}
+
+// IsUnique checks that all elements in the slice are unique.
+func (c *SliceCheck[T]) IsUnique() error {
+	return run(func(t internal.T) {
+		c.assert(t).IsUnique()
+	})
+}
+
+// IsUniqueBy checks that all elements in the slice are unique based on a custom function.
+func (c *SliceCheck[T]) IsUniqueBy(fn func(T) interface{}) error {
+	return run(func(t internal.T) {
+		c.assert(t).IsUniqueBy(fn)
+	})
+}
+
+// All checks that all elements in the slice satisfy the given condition.
+func (c *SliceCheck[T]) All(fn func(T) bool) error {
+	return run(func(t internal.T) {
+		c.assert(t).All(fn)
+	})
+}
+
+// Any checks that at least one element in the slice satisfies the given condition.
+func (c *SliceCheck[T]) Any(fn func(T) bool) error {
+	return run(func(t internal.T) {
+		c.assert(t).Any(fn)
+	})
+}
+
+// None checks that no element in the slice satisfies the given condition.
+func (c *SliceCheck[T]) None(fn func(T) bool) error {
+	return run(func(t internal.T) {
+		c.assert(t).None(fn)
+	})
+}
@@ -0,0 +1,212 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package check
+
+import (
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/internal"
+)
+
+// StringCheck wraps a string value for fluent checks.
+type StringCheck struct {
+	v                 string
+	ignoreLineEndings bool // see IgnoringLineEndings
+}
+
+// ThatString returns a StringCheck for a string value v.
+func ThatString(v string) *StringCheck {
+	return &StringCheck{v: v}
+}
+
+// IgnoringLineEndings returns a StringCheck that normalizes line endings,
+// see assert.StringAssertion.IgnoringLineEndings.
+func (c *StringCheck) IgnoringLineEndings() *StringCheck {
+	return &StringCheck{v: c.v, ignoreLineEndings: true}
+}
+
+// assert returns the assertion of the check made through t.
+func (c *StringCheck) assert(t internal.T) *assert.StringAssertion {
+	a := assert.ThatString(t, c.v)
+	if c.ignoreLineEndings {
+		a = a.IgnoringLineEndings()
+	}
+	return a
+}
+
+// Length returns an error if the actual string's length is not equal to the expected length.
+func (c *StringCheck) Length(length int) error {
+	return run(func(t internal.T) {
+		c.assert(t).Length(length)
+	})
+}
+
+// Equal returns an error if the actual string is not equal to the expected string.
+func (c *StringCheck) Equal(expect string) error {
+	return run(func(t internal.T) {
+		c.assert(t).Equal(expect)
+	})
+}
+
+// NotEqual returns an error if the actual string is equal to the given string.
+func (c *StringCheck) NotEqual(expect string) error {
+	return run(func(t internal.T) {
+		c.assert(t).NotEqual(expect)
+	})
+}
+
+// JSONEqual unmarshals both the actual and expected JSON strings into generic interfaces,
+// then returns an error if their resulting structures are not deeply equal.
+// If either string is invalid JSON, it returns an error naming the unmarshal error.
+func (c *StringCheck) JSONEqual(expect string) error {
+	return run(func(t internal.T) {
+		c.assert(t).JSONEqual(expect)
+	})
+}
+
+// Matches returns an error if the actual string does not match the given regular expression.
+func (c *StringCheck) Matches(expr string) error {
+	return run(func(t internal.T) {
+		c.assert(t).Matches(expr)
+	})
+}
+
+// EqualFold returns an error if the actual string and the given string
+// are not equal under Unicode case-folding.
+func (c *StringCheck) EqualFold(s string) error {
+	return run(func(t internal.T) {
+		c.assert(t).EqualFold(s)
+	})
+}
+
+// HasPrefix returns an error if the actual string does not start with the specified prefix.
+func (c *StringCheck) HasPrefix(prefix string) error {
+	return run(func(t internal.T) {
+		c.assert(t).HasPrefix(prefix)
+	})
+}
+
+// HasSuffix returns an error if the actual string does not end with the specified suffix.
+func (c *StringCheck) HasSuffix(suffix string) error {
+	return run(func(t internal.T) {
+		c.assert(t).HasSuffix(suffix)
+	})
+}
+
+// Contains returns an error if the actual string does not contain the specified substring.
+func (c *StringCheck) Contains(substr string) error {
+	return run(func(t internal.T) {
+		c.assert(t).Contains(substr)
+	})
+}
+
+// IsEmpty returns an error if the actual string is not empty.
+func (c *StringCheck) IsEmpty() error {
+	return run(func(t internal.T) {
+		c.assert(t).IsEmpty()
+	})
+}
+
+// IsNotEmpty returns an error if the actual string is empty.
+func (c *StringCheck) IsNotEmpty() error {
+	return run(func(t internal.T) {
+		c.assert(t).IsNotEmpty()
+	})
+}
+
+// IsBlank returns an error if the actual string is not blank (i.e., contains non-whitespace characters).
+func (c *StringCheck) IsBlank() error {
+	return run(func(t internal.T) {
+		c.assert(t).IsBlank()
+	})
+}
+
+// IsNotBlank returns an error if the actual string is blank (i.e., empty or contains only whitespace characters).
+func (c *StringCheck) IsNotBlank() error {
+	return run(func(t internal.T) {
+		c.assert(t).IsNotBlank()
+	})
+}
+
+// IsLowerCase returns an error if the actual string contains any uppercase characters.
+func (c *StringCheck) IsLowerCase() error {
+	return run(func(t internal.T) {
+		c.assert(t).IsLowerCase()
+	})
+}
+
+// IsUpperCase returns an error if the actual string contains any lowercase characters.
+func (c *StringCheck) IsUpperCase() error {
+	return run(func(t internal.T) {
+		c.assert(t).IsUpperCase()
+	})
+}
+
+// IsNumeric returns an error if the actual string contains any non-numeric characters.
+func (c *StringCheck) IsNumeric() error {
+	return run(func(t internal.T) {
+		c.assert(t).IsNumeric()
+	})
+}
+
+// IsAlpha returns an error if the actual string contains any non-alphabetic characters.
+func (c *StringCheck) IsAlpha() error {
+	return run(func(t internal.T) {
+		c.assert(t).IsAlpha()
+	})
+}
+
+// IsAlphaNumeric returns an error if the actual string contains any non-alphanumeric characters.
+func (c *StringCheck) IsAlphaNumeric() error {
+	return run(func(t internal.T) {
+		c.assert(t).IsAlphaNumeric()
+	})
+}
+
+// IsEmail returns an error if the actual string is not a valid email address.
+func (c *StringCheck) IsEmail() error {
+	return run(func(t internal.T) {
+		c.assert(t).IsEmail()
+	})
+}
+
+// IsURL returns an error if the actual string is not a valid URL.
+func (c *StringCheck) IsURL() error {
+	return run(func(t internal.T) {
+		c.assert(t).IsURL()
+	})
+}
+
+// IsIP returns an error if the actual string is not a valid IP address.
+func (c *StringCheck) IsIP() error {
+	return run(func(t internal.T) {
+		c.assert(t).IsIP()
+	})
+}
+
+// IsHex returns an error if the actual string is not a valid hexadecimal number.
+func (c *StringCheck) IsHex() error {
+	return run(func(t internal.T) {
+		c.assert(t).IsHex()
+	})
+}
+
+// IsBase64 returns an error if the actual string is not a valid Base64 encoded string.
+func (c *StringCheck) IsBase64() error {
+	return run(func(t internal.T) {
+		c.assert(t).IsBase64()
+	})
+}
@@ -0,0 +1,202 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package check
+
+import (
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/internal"
+)
+
+// ThatCheck wraps a value for fluent checks.
+type ThatCheck struct {
+	v interface{}
+}
+
+// That returns a ThatCheck for a value v.
+func That(v interface{}) *ThatCheck {
+	return &ThatCheck{v: v}
+}
+
+// assert returns the assertion of the check made through t.
+func (c *ThatCheck) assert(t internal.T) *assert.ThatAssertion {
+	return assert.That(t, c.v)
+}
+
+// Equal checks that the wrapped value v is deeply equal to expect.
+// It returns an error if the values are not deeply equal.
+func (c *ThatCheck) Equal(expect interface{}) error {
+	return run(func(t internal.T) {
+		c.assert(t).Equal(expect)
+	})
+}
+
+// EqualIgnoring checks that the wrapped value v is deeply equal to expect
+// while skipping the given struct field paths, such as generated IDs and
+// timestamps. A path is a dotted chain of field names, e.g. "Meta.CreatedAt",
+// and applies to every element when it passes through slices and maps.
+// It returns an error naming the first differing path otherwise.
+func (c *ThatCheck) EqualIgnoring(expect interface{}, fields ...string) error {
+	return run(func(t internal.T) {
+		c.assert(t).EqualIgnoring(expect, assert.IgnoringFields(fields...))
+	})
+}
+
+// EqualGraph checks that the wrapped value v is deeply equal to expect and
+// that both object graphs share pointers in the same way: whenever two
+// pointers or maps in v refer to the same object, the corresponding ones in
+// expect must do so too, and vice versa. It returns an error naming the
+// first differing path otherwise.
+func (c *ThatCheck) EqualGraph(expect interface{}) error {
+	return run(func(t internal.T) {
+		c.assert(t).EqualGraph(expect)
+	})
+}
+
+// DiffReport checks that the wrapped value v is deeply equal to expect,
+// comparing exported struct fields only. Unlike Equal, it does not stop at
+// the first mismatch: the error lists every differing field path in a table
+// with got and expect columns, which suits large domain objects.
+func (c *ThatCheck) DiffReport(expect interface{}) error {
+	return run(func(t internal.T) {
+		c.assert(t).DiffReport(expect)
+	})
+}
+
+// NotEqual checks that the wrapped value v is not deeply equal to expect.
+// It returns an error if the values are deeply equal.
+func (c *ThatCheck) NotEqual(expect interface{}) error {
+	return run(func(t internal.T) {
+		c.assert(t).NotEqual(expect)
+	})
+}
+
+// Same checks that the wrapped value v and expect are the same (using Go ==).
+// It returns an error if v != expect.
+func (c *ThatCheck) Same(expect interface{}) error {
+	return run(func(t internal.T) {
+		c.assert(t).Same(expect)
+	})
+}
+
+// NotSame checks that the wrapped value v and expect are not the same (using Go !=).
+// It returns an error if v == expect.
+func (c *ThatCheck) NotSame(expect interface{}) error {
+	return run(func(t internal.T) {
+		c.assert(t).NotSame(expect)
+	})
+}
+
+// TypeOf checks that the type of the wrapped value v is assignable to the type of expect.
+// It supports pointer to interface types.
+// It returns an error if the types are not assignable.
+func (c *ThatCheck) TypeOf(expect interface{}) error {
+	return run(func(t internal.T) {
+		c.assert(t).TypeOf(expect)
+	})
+}
+
+// Implements checks that the type of the wrapped value v implements the interface type of expect.
+// The expect parameter must be an interface or pointer to interface.
+// It returns an error if v does not implement the interface.
+// See also the generic assert.Implements function.
+func (c *ThatCheck) Implements(expect interface{}) error {
+	return run(func(t internal.T) {
+		c.assert(t).Implements(expect)
+	})
+}
+
+// Has checks that the wrapped value v has a method named 'Has' that returns true when passed expect.
+// It returns an error if the method does not exist or returns false.
+func (c *ThatCheck) Has(expect interface{}) error {
+	return run(func(t internal.T) {
+		c.assert(t).Has(expect)
+	})
+}
+
+// Contains checks that the wrapped value v has a method named 'Contains' that returns true when passed expect.
+// It returns an error if the method does not exist or returns false.
+func (c *ThatCheck) Contains(expect interface{}) error {
+	return run(func(t internal.T) {
+		c.assert(t).Contains(expect)
+	})
+}
+
+// InSlice checks that the wrapped value v is present in the provided slice or array.
+// It returns an error if expect is not a slice/array or if v is not found.
+func (c *ThatCheck) InSlice(expect interface{}) error {
+	return run(func(t internal.T) {
+		c.assert(t).InSlice(expect)
+	})
+}
+
+// NotInSlice checks that the wrapped value v is not present in the provided slice or array.
+// It returns an error if expect is not a slice/array, if types do not match, or if v is found.
+func (c *ThatCheck) NotInSlice(expect interface{}) error {
+	return run(func(t internal.T) {
+		c.assert(t).NotInSlice(expect)
+	})
+}
+
+// InMapKeys checks that the value is one of the keys in the provided map.
+// It returns an error if the expected value is not a map or if the actual value
+// does not match any key in the map.
+func (c *ThatCheck) InMapKeys(expect interface{}) error {
+	return run(func(t internal.T) {
+		c.assert(t).InMapKeys(expect)
+	})
+}
+
+// InMapValues checks that the value is one of the values in the provided map.
+// It returns an error if the expected value is not a map or if the actual value
+// does not match any value in the map.
+func (c *ThatCheck) InMapValues(expect interface{}) error {
+	return run(func(t internal.T) {
+		c.assert(t).InMapValues(expect)
+	})
+}
+
+// IsZero checks that the wrapped value v is the zero value for its type.
+// It returns an error if the value is not zero.
+func (c *ThatCheck) IsZero() error {
+	return run(func(t internal.T) {
+		c.assert(t).IsZero()
+	})
+}
+
+// NotZero checks that the wrapped value v is not the zero value for its type.
+// It returns an error if the value is zero.
+func (c *ThatCheck) NotZero() error {
+	return run(func(t internal.T) {
+		c.assert(t).NotZero()
+	})
+}
+
+// IsType checks that the wrapped value v is of the same type as expect.
+// It returns an error if the types are not the same.
+func (c *ThatCheck) IsType(expect interface{}) error {
+	return run(func(t internal.T) {
+		c.assert(t).IsType(expect)
+	})
+}
+
+// IsNotType checks that the wrapped value v is not of the same type as expect.
+// It returns an error if the types are the same.
+func (c *ThatCheck) IsNotType(expect interface{}) error {
+	return run(func(t internal.T) {
+		c.assert(t).IsNotType(expect)
+	})
+}
@@ -0,0 +1,168 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/lvan100/go-assert/internal"
+)
+
+// Defaults used by HTTPClientGet and HTTPClientDo.
+const (
+	DefaultClientTimeout       = 5 * time.Second
+	DefaultClientRetries       = 3
+	DefaultClientRetryInterval = 100 * time.Millisecond
+)
+
+// clientConfig holds the settings of a client call.
+type clientConfig struct {
+	client   *http.Client
+	timeout  time.Duration
+	retries  int
+	interval time.Duration
+}
+
+// ClientOption configures HTTPClientGet and HTTPClientDo.
+type ClientOption func(c *clientConfig)
+
+// WithClient returns a ClientOption that sends requests with the given
+// client, e.g. the one returned by httptest.Server.Client for TLS servers.
+func WithClient(client *http.Client) ClientOption {
+	return func(c *clientConfig) {
+		c.client = client
+	}
+}
+
+// WithClientTimeout returns a ClientOption that limits each attempt,
+// including reading the response body, to d.
+func WithClientTimeout(d time.Duration) ClientOption {
+	return func(c *clientConfig) {
+		c.timeout = d
+	}
+}
+
+// WithClientRetries returns a ClientOption that retries a request up to n
+// times, waiting interval between attempts, when it fails at the transport
+// level, e.g. because the server is not yet listening, or gets a 502, 503
+// or 504 response, e.g. from a proxy whose backend is still starting.
+func WithClientRetries(n int, interval time.Duration) ClientOption {
+	return func(c *clientConfig) {
+		c.retries = n
+		c.interval = interval
+	}
+}
+
+// HTTPClientGet issues a GET request to url and returns a ResponseAssertion
+// over the response, whose body has already been read and closed. It is a
+// shorthand for integration-style tests against an httptest.Server:
+//
+//	assert.HTTPClientGet(t, server.URL+"/health").StatusIs(200)
+func HTTPClientGet(t internal.T, url string, opts ...ClientOption) *ResponseAssertion {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		str := fmt.Sprintf(`unable to build request:
+ method: GET
+    url: %s
+  error: %v`, url, err)
+		a := &ResponseAssertion{t: chain(t)}
+		fail(a.t, str)
+		return a
+	}
+	return HTTPClientDo(t, req, opts...)
+}
+
+// HTTPClientDo sends req with a per-attempt timeout, retrying transport
+// errors and 502, 503 and 504 responses, and returns a ResponseAssertion
+// over the response, whose body has already been read and closed. Requests
+// with a body are only retried if req.GetBody is set. A test failure is
+// reported if all attempts fail at the transport level; if the last one
+// gets a retried status, its response is returned.
+func HTTPClientDo(t internal.T, req *http.Request, opts ...ClientOption) *ResponseAssertion {
+	t.Helper()
+	c := clientConfig{
+		client:   http.DefaultClient,
+		timeout:  DefaultClientTimeout,
+		retries:  DefaultClientRetries,
+		interval: DefaultClientRetryInterval,
+	}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	var err error
+	for attempt := 0; ; attempt++ {
+		var resp *http.Response
+		resp, err = c.do(req)
+		if err == nil && !retriedStatus(resp.StatusCode) {
+			return ThatResponse(t, resp)
+		}
+		if attempt >= c.retries || (req.Body != nil && req.Body != http.NoBody && req.GetBody == nil) {
+			if err == nil {
+				return ThatResponse(t, resp)
+			}
+			str := fmt.Sprintf(`HTTP request failed:
+ method: %s
+    url: %s
+  tries: %d
+  error: %v`, req.Method, req.URL, attempt+1, err)
+			a := &ResponseAssertion{t: chain(t)}
+			fail(a.t, str)
+			return a
+		}
+		time.Sleep(c.interval)
+		if req.GetBody != nil {
+			if req.Body, err = req.GetBody(); err != nil {
+				str := fmt.Sprintf(`unable to rewind request body:
+ method: %s
+    url: %s
+  error: %v`, req.Method, req.URL, err)
+				a := &ResponseAssertion{t: chain(t)}
+				fail(a.t, str)
+				return a
+			}
+		}
+	}
+}
+
+// retriedStatus reports whether a response with the given status code is
+// retried, as gateways return it while their backend is unavailable.
+func retriedStatus(code int) bool {
+	switch code {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// do performs a single attempt, reading the whole body before the attempt's
+// deadline expires.
+func (c *clientConfig) do(req *http.Request) (*http.Response, error) {
+	ctx, cancel := context.WithTimeout(req.Context(), c.timeout)
+	defer cancel()
+	resp, err := c.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if _, resp.Body, err = readAndRestore(resp.Body); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
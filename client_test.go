@@ -0,0 +1,156 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/internal/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestHTTPClientGet(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/health":
+			_, _ = io.WriteString(w, "ok")
+		case "/slow":
+			time.Sleep(200 * time.Millisecond)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	runCase(t, func(g *mock.MockT) {
+		assert.HTTPClientGet(g, server.URL+"/health").StatusIs(http.StatusOK).BodyEqual("ok")
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`status code mismatch:
+    got: 404 Not Found
+ expect: 200 OK`})
+		assert.HTTPClientGet(g, server.URL+"/missing").StatusIs(http.StatusOK)
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error(gomock.Any()).Do(func(args ...interface{}) {
+			s := args[0].(string)
+			assert.ThatString(t, s).HasPrefix("HTTP request failed:\n method: GET\n    url: " + server.URL + "/slow\n  tries: 2\n")
+			assert.ThatString(t, s).Contains("context deadline exceeded")
+		})
+		assert.HTTPClientGet(g, server.URL+"/slow",
+			assert.WithClientTimeout(50*time.Millisecond),
+			assert.WithClientRetries(1, time.Millisecond),
+		).StatusIs(http.StatusOK)
+	})
+}
+
+// flakyTransport fails the first n round trips with a transport error.
+type flakyTransport struct {
+	n     int
+	calls int
+}
+
+func (f *flakyTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	if f.calls++; f.calls <= f.n {
+		return nil, errors.New("connection refused")
+	}
+	return http.DefaultTransport.RoundTrip(r)
+}
+
+func TestHTTPClientDo_Retry(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		_, _ = w.Write(b)
+	}))
+	defer server.Close()
+
+	runCase(t, func(g *mock.MockT) {
+		tr := &flakyTransport{n: 2}
+		req, _ := http.NewRequest(http.MethodPost, server.URL, strings.NewReader("ping"))
+		assert.HTTPClientDo(g, req,
+			assert.WithClient(&http.Client{Transport: tr}),
+			assert.WithClientRetries(2, time.Millisecond),
+		).StatusIs(http.StatusOK).BodyEqual("ping")
+		assert.That(t, tr.calls).Equal(3)
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`HTTP request failed:
+ method: GET
+    url: ` + server.URL + `
+  tries: 3
+  error: Get "` + server.URL + `": connection refused`})
+		assert.HTTPClientGet(g, server.URL,
+			assert.WithClient(&http.Client{Transport: &flakyTransport{n: 5}}),
+			assert.WithClientRetries(2, time.Millisecond),
+		)
+	})
+}
+
+func TestHTTPClientDo_RetryStatus(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := calls.Add(1)
+		switch {
+		case r.URL.Path == "/down":
+			w.WriteHeader(http.StatusBadGateway)
+		case n == 1:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case n == 2:
+			w.WriteHeader(http.StatusGatewayTimeout)
+		case r.URL.Path == "/missing":
+			http.NotFound(w, r)
+		default:
+			_, _ = io.WriteString(w, "ok")
+		}
+	}))
+	defer server.Close()
+
+	runCase(t, func(g *mock.MockT) {
+		calls.Store(0)
+		assert.HTTPClientGet(g, server.URL+"/health",
+			assert.WithClientRetries(2, time.Millisecond),
+		).StatusIs(http.StatusOK).BodyEqual("ok")
+		assert.That(t, calls.Load()).Equal(int32(3))
+	})
+	runCase(t, func(g *mock.MockT) {
+		// other failing statuses are not retried
+		calls.Store(2)
+		assert.HTTPClientGet(g, server.URL+"/missing",
+			assert.WithClientRetries(2, time.Millisecond),
+		).StatusIs(http.StatusNotFound)
+		assert.That(t, calls.Load()).Equal(int32(3))
+	})
+	runCase(t, func(g *mock.MockT) {
+		// the last response is returned once the retries are spent
+		calls.Store(0)
+		g.EXPECT().Error([]interface{}{`status code mismatch:
+    got: 502 Bad Gateway
+ expect: 200 OK`})
+		assert.HTTPClientGet(g, server.URL+"/down",
+			assert.WithClientRetries(2, time.Millisecond),
+		).StatusIs(http.StatusOK)
+		assert.That(t, calls.Load()).Equal(int32(3))
+	})
+}
@@ -0,0 +1,152 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package cmdassert offers assertions on running commands, kept apart from
+// package assert so that package check, which serves non-test code, does
+// not import os/exec:
+//
+//	cmd := exec.Command("mytool", "--version")
+//	cmdassert.Run(t, cmd, 5*time.Second).Succeeds().Stdout().HasPrefix("mytool v")
+package cmdassert
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/lvan100/go-assert"
+)
+
+// CmdAssertion encapsulates the outcome of running a command and a test
+// handler for making assertions on it. Created by Run.
+type CmdAssertion struct {
+	t      assert.T
+	cmd    *exec.Cmd
+	stdout bytes.Buffer
+	stderr bytes.Buffer
+	code   int
+	ok     bool // the command ran to completion
+}
+
+// Run runs cmd, capturing its stdout and stderr in addition to any writers
+// already set on it, and returns a CmdAssertion over the outcome. It reports
+// a test failure if the command cannot be started or, for a positive
+// timeout, does not complete within it, in which case the process is killed.
+func Run(t assert.T, cmd *exec.Cmd, timeout time.Duration, msg ...interface{}) *CmdAssertion {
+	t.Helper()
+	a := &CmdAssertion{t: assert.Chain(t), cmd: cmd, code: -1}
+	assert.Check(a.t, a.run(timeout), msg...)
+	return a
+}
+
+// run returns the comparison running the command.
+func (a *CmdAssertion) run(timeout time.Duration) assert.Comparison {
+	return func() assert.Result {
+		cmd := a.cmd
+		cmd.Stdout = teeWriter(&a.stdout, cmd.Stdout)
+		cmd.Stderr = teeWriter(&a.stderr, cmd.Stderr)
+		if timeout > 0 && cmd.WaitDelay == 0 {
+			// children of a killed process may hold its output pipes open
+			cmd.WaitDelay = time.Second
+		}
+		if err := cmd.Start(); err != nil {
+			return assert.ResultFailure("failed to start command:\ncommand: %s\n  error: %v", cmd, err)
+		}
+		done := make(chan error, 1)
+		go func() { done <- cmd.Wait() }()
+		var timer <-chan time.Time
+		if timeout > 0 {
+			timer = time.After(timeout)
+		}
+		var err error
+		select {
+		case err = <-done:
+		case <-timer:
+			_ = cmd.Process.Kill()
+			<-done
+			return assert.ResultFailure("command did not complete within %v:\ncommand: %s%s", timeout, cmd, a.output())
+		}
+		var exitErr *exec.ExitError
+		switch {
+		case err == nil:
+			a.code, a.ok = 0, true
+		case errors.As(err, &exitErr) && exitErr.Exited():
+			a.code, a.ok = exitErr.ExitCode(), true
+		default:
+			return assert.ResultFailure("command failed:\ncommand: %s\n  error: %v%s", cmd, err, a.output())
+		}
+		return assert.ResultSuccess()
+	}
+}
+
+// teeWriter returns a writer writing to buf and, if set, to w.
+func teeWriter(buf *bytes.Buffer, w io.Writer) io.Writer {
+	if w == nil {
+		return buf
+	}
+	return io.MultiWriter(buf, w)
+}
+
+// output describes the captured output for failure messages.
+func (a *CmdAssertion) output() string {
+	var sb strings.Builder
+	if a.stdout.Len() > 0 {
+		fmt.Fprintf(&sb, "\n stdout: %s", strings.TrimRight(a.stdout.String(), "\n"))
+	}
+	if a.stderr.Len() > 0 {
+		fmt.Fprintf(&sb, "\n stderr: %s", strings.TrimRight(a.stderr.String(), "\n"))
+	}
+	return sb.String()
+}
+
+// ExitCode reports a test failure if the command did not exit with code.
+func (a *CmdAssertion) ExitCode(code int, msg ...interface{}) *CmdAssertion {
+	a.t.Helper()
+	assert.Check(a.t, func() assert.Result {
+		if !a.ok {
+			return assert.ResultFailure("command did not exit:\ncommand: %s\n expect: exit code %d", a.cmd, code)
+		}
+		if a.code != code {
+			return assert.ResultFailure(`exit code mismatch:
+command: %s
+    got: %d
+ expect: %d%s`, a.cmd, a.code, code, a.output())
+		}
+		return assert.ResultSuccess()
+	}, msg...)
+	return a
+}
+
+// Succeeds reports a test failure if the command did not exit with code 0.
+func (a *CmdAssertion) Succeeds(msg ...interface{}) *CmdAssertion {
+	a.t.Helper()
+	return a.ExitCode(0, msg...)
+}
+
+// Stdout returns a StringAssertion over the captured standard output,
+// giving access to the full set of string assertions.
+func (a *CmdAssertion) Stdout() *assert.StringAssertion {
+	return assert.ThatString(a.t, a.stdout.String())
+}
+
+// Stderr returns a StringAssertion over the captured standard error.
+func (a *CmdAssertion) Stderr() *assert.StringAssertion {
+	return assert.ThatString(a.t, a.stderr.String())
+}
@@ -0,0 +1,82 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmdassert_test
+
+import (
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/cmdassert"
+	"github.com/lvan100/go-assert/internal/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestMain(m *testing.M) {
+	assert.Configure(func(s *assert.Settings) {
+		s.Color = assert.ColorNever
+	})
+	os.Exit(m.Run())
+}
+
+func runCase(t *testing.T, f func(g *mock.MockT)) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	g := mock.NewMockT(ctrl)
+	g.EXPECT().Helper().AnyTimes()
+	f(g)
+}
+
+func TestRun(t *testing.T) {
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip("sh not available")
+	}
+
+	runCase(t, func(g *mock.MockT) {
+		cmd := exec.Command("sh", "-c", "echo hello; echo oops >&2")
+		a := cmdassert.Run(g, cmd, time.Minute).Succeeds()
+		a.Stdout().Equal("hello\n")
+		a.Stderr().Contains("oops")
+	})
+
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`exit code mismatch:
+command: /bin/sh -c echo bad >&2; exit 3
+    got: 3
+ expect: 0
+ stderr: bad
+message: build`})
+		cmd := exec.Command("/bin/sh", "-c", "echo bad >&2; exit 3")
+		cmdassert.Run(g, cmd, 0).Succeeds("build").ExitCode(3)
+	})
+
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"command did not complete within 50ms:\ncommand: /bin/sh -c sleep 10"})
+		cmd := exec.Command("/bin/sh", "-c", "sleep 10")
+		cmdassert.Run(g, cmd, 50*time.Millisecond).Succeeds()
+	})
+
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error(gomock.Cond(func(args []interface{}) bool {
+			return strings.HasPrefix(args[0].(string), "failed to start command:\ncommand: /nonexistent/tool\n  error: ")
+		}))
+		cmdassert.Run(g, exec.Command("/nonexistent/tool"), 0)
+	})
+}
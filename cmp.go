@@ -0,0 +1,143 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/lvan100/go-assert/internal"
+)
+
+// cmpOptionsOf returns the go-cmp options of the comparisons made through
+// t: as set by the outermost Asserter setting them, or else by the
+// package-level setting.
+func cmpOptionsOf(t internal.T) []cmp.Option {
+	for _, a := range asserterOf(t) {
+		if a.cmpOptions != nil {
+			return a.cmpOptions
+		}
+	}
+	return currentSettings().CmpOptions
+}
+
+// equalValues reports whether got and expect are deeply equal: with go-cmp
+// if options are set for t, see Settings.CmpOptions, or else as
+// reflect.DeepEqual does. If go-cmp cannot compare them, e.g. because of
+// unexported fields no option handles, it reports a failure and returns
+// false for ok.
+func equalValues(t internal.T, got, expect interface{}, msg ...interface{}) (equal, ok bool) {
+	t.Helper()
+	return equalWith(t, got, expect, cmpOptionsOf(t), msg...)
+}
+
+// equalWith is equalValues with the go-cmp options opts.
+func equalWith(t internal.T, got, expect interface{}, opts []cmp.Option, msg ...interface{}) (equal, ok bool) {
+	t.Helper()
+	if len(opts) == 0 {
+		return deepEqual(got, expect), true
+	}
+	equal, err := cmpEqual(got, expect, opts)
+	if err != nil {
+		str := fmt.Sprintf(`unable to compare values:
+    got: (%T) %v
+ expect: (%T) %v
+  error: %v`, got, show(t, got), expect, show(t, expect), err)
+		failUsage(t, str, msg...)
+		return false, false
+	}
+	return equal, true
+}
+
+// cmpEqual is cmp.Equal returning its panic as an error.
+func cmpEqual(x, y interface{}, opts []cmp.Option) (equal bool, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+	return cmp.Equal(x, y, opts...), nil
+}
+
+// elementsEqual returns the equality of the elements of slices and maps
+// compared through t: with go-cmp if options are set for t, or else ==.
+func elementsEqual[T comparable](t internal.T) func(x, y T) bool {
+	if opts := cmpOptionsOf(t); len(opts) > 0 {
+		return func(x, y T) bool {
+			equal, err := cmpEqual(x, y, opts)
+			return err == nil && equal
+		}
+	}
+	return func(x, y T) bool {
+		return x == y
+	}
+}
+
+// withCmpOption returns the options set for t, see Settings.CmpOptions,
+// followed by opt unless nil.
+func withCmpOption(t internal.T, opt cmp.Option) []cmp.Option {
+	opts := cmpOptionsOf(t)
+	if opt == nil {
+		return opts
+	}
+	return append(opts[:len(opts):len(opts)], opt)
+}
+
+// EqualCmp asserts that the wrapped value v is equal to expect as compared
+// by go-cmp with opt, e.g. cmpopts.IgnoreFields, cmpopts.EquateApprox or
+// cmp.Comparer, on top of the options set for the test handler, see
+// Settings.CmpOptions. Several options are combined with cmp.Options:
+//
+//	assert.That(t, got).EqualCmp(expect, cmp.Options{
+//		cmpopts.IgnoreUnexported(User{}),
+//		cmpopts.EquateApprox(0, 1e-9),
+//	}, "user")
+//
+// It reports an error with the go-cmp diff otherwise.
+func (a *ThatAssertion) EqualCmp(expect interface{}, opt cmp.Option, msg ...interface{}) (ok bool) {
+	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
+	opts := withCmpOption(a.t, opt)
+	equal, err := cmpEqual(a.v, expect, opts)
+	if err != nil {
+		str := fmt.Sprintf(`unable to compare values:
+    got: (%T) %v
+ expect: (%T) %v
+  error: %v`, a.v, show(a.t, a.v), expect, show(a.t, expect), err)
+		failUsage(a.t, str, msg...)
+		return false
+	}
+	if !equal {
+		// go-cmp randomly emits non-breaking spaces to deter parsing its output
+		d := strings.ReplaceAll(cmp.Diff(expect, a.v, opts...), "\u00a0", " ")
+		d = strings.TrimRight(d, "\n")
+		str := fmt.Sprintf("values not equal:\n   type: (%T)\n   diff: (-expect +got)\n%s", a.v, d)
+		failValues(a.t, a.v, expect, str, msg...)
+		return false
+	}
+	return true
+}
+
+// JSONEqualCmp is like JSONEqual, but compares the decoded documents with
+// go-cmp and opt, e.g. cmpopts.EquateApprox for numbers, on top of the
+// options set for the test handler, see Settings.CmpOptions and EqualCmp.
+func (a *StringAssertion) JSONEqualCmp(expect string, opt cmp.Option, msg ...interface{}) (ok bool) {
+	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
+	return a.jsonEqual(expect, withCmpOption(a.t, opt), msg...)
+}
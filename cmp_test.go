@@ -0,0 +1,132 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/internal/mock"
+	"go.uber.org/mock/gomock"
+)
+
+type cmpUser struct {
+	Name  string
+	Score float64
+	cache []byte
+}
+
+func TestCmpOptions(t *testing.T) {
+	got := cmpUser{Name: "bob", Score: 1.0001, cache: []byte("x")}
+	expect := cmpUser{Name: "bob", Score: 1}
+	runCase(t, func(g *mock.MockT) {
+		a := assert.New(g).WithCmpOptions(cmpopts.IgnoreUnexported(cmpUser{}), cmpopts.EquateApprox(0, 0.001))
+		assert.That(a, got).Equal(expect)
+		assert.That(a, got).NotEqual(cmpUser{Name: "alice"})
+		assert.ThatSlice(a, []float64{1, 2.0001}).Equal([]float64{1, 2})
+		assert.ThatSlice(a, []float64{1, 2.1}).NotEqual([]float64{1, 2})
+		assert.ThatMap(a, map[string]float64{"a": 1.0001}).Equal(map[string]float64{"a": 1})
+		assert.ThatString(a, `{"a":1.0001}`).JSONEqual(`{"a":1}`)
+		assert.ThatStruct(a, struct{ U cmpUser }{got}).FieldEqual("U", expect)
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"got element 2.1 at index 1 but expect 2"})
+		a := assert.New(g).WithCmpOptions(cmpopts.EquateApprox(0, 0.001))
+		assert.ThatSlice(a, []float64{1, 2.1}).Equal([]float64{1, 2})
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error(gomock.Cond(func(args []interface{}) bool {
+			return strings.HasPrefix(fmt.Sprint(args...), `unable to compare values:
+    got: (assert_test.cmpUser) {bob 1.0001 [120]}
+ expect: (assert_test.cmpUser) {bob 1 []}
+  error: cannot handle unexported field`)
+		}))
+		a := assert.New(g).WithCmpOptions(cmpopts.EquateApprox(0, 0.001))
+		assert.That(a, got).Equal(expect)
+	})
+
+	defer assert.Configure(func(s *assert.Settings) {
+		s.CmpOptions = []cmp.Option{cmpopts.IgnoreUnexported(cmpUser{})}
+	})()
+	runCase(t, func(g *mock.MockT) {
+		assert.That(g, cmpUser{cache: []byte("x")}).Equal(cmpUser{})
+	})
+}
+
+func TestString_JSONEqualCmp(t *testing.T) {
+	runCase(t, func(g *mock.MockT) {
+		assert.ThatString(g, `{"a":1.0001,"s":"\u0007"}`).JSONEqualCmp(`{"s":"\u0007","a":1}`, cmpopts.EquateApprox(0, 0.001))
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`JSON structures are not equal:
+    got: (string) "{\"s\":\"\\u0007x\"}"
+ expect: (string) "{\"s\":\"\\u0007y\"}"`})
+		// the canonical forms escape control characters as JSON requires
+		assert.ThatString(g, `{"s":"\u0007x"}`).JSONEqualCmp(`{"s":"\u0007y"}`, cmpopts.EquateApprox(0, 0.001))
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`JSON structures are not equal:
+    got: (string) "{\"a\":1}"
+ expect: (string) "{\"a\":2}"
+message: body`})
+		assert.ThatString(g, `{"a":1}`).JSONEqualCmp(`{"a":2}`, nil, "body")
+	})
+}
+
+func TestThat_EqualCmp(t *testing.T) {
+	runCase(t, func(g *mock.MockT) {
+		got := cmpUser{Name: "bob", Score: 1.0001, cache: []byte("x")}
+		assert.That(g, got).EqualCmp(cmpUser{Name: "bob", Score: 1}, cmp.Options{
+			cmpopts.IgnoreUnexported(cmpUser{}), cmpopts.EquateApprox(0, 0.001),
+		})
+		a := assert.New(g).WithCmpOptions(cmpopts.IgnoreUnexported(cmpUser{}))
+		assert.That(a, got).EqualCmp(cmpUser{Name: "bob", Score: 1}, cmpopts.EquateApprox(0, 0.001))
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error(gomock.Any()).Do(func(args ...interface{}) {
+			s := args[0].(string)
+			assert.ThatString(t, s).HasPrefix("values not equal:\n   type: (assert_test.cmpUser)\n   diff: (-expect +got)\n")
+			assert.ThatString(t, s).Contains("- \tName:  \"alice\",")
+			assert.ThatString(t, s).Contains("+ \tName:  \"bob\",")
+		})
+		got := cmpUser{Name: "bob", Score: 1}
+		assert.That(g, got).EqualCmp(cmpUser{Name: "alice", Score: 1}, cmpopts.IgnoreUnexported(cmpUser{}))
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error(gomock.Any()).Do(func(args ...interface{}) {
+			assert.ThatString(t, args[0].(string)).HasPrefix("unable to compare values:")
+		})
+		assert.That(g, cmpUser{}).EqualCmp(cmpUser{}, nil)
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error(gomock.Any()).Do(func(args ...interface{}) {
+			assert.ThatString(t, args[0].(string)).HasSuffix("\nmessage: user 1")
+		})
+		assert.That(g, cmpUser{Name: "bob"}).EqualCmp(cmpUser{Name: "alice"}, cmpopts.IgnoreUnexported(cmpUser{}), "user %d", 1)
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error(gomock.Any()).Do(func(args ...interface{}) {
+			assert.ThatString(t, args[0].(string)).HasPrefix("unable to compare values:")
+		})
+		// values that cannot be compared fail even if the check is inverted
+		assert.ThatBool(t, assert.That(g, cmpUser{}).Not().EqualCmp(cmpUser{Name: "bob"}, nil)).IsFalse()
+	})
+}
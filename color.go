@@ -0,0 +1,92 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"os"
+	"strings"
+
+	"github.com/lvan100/go-assert/internal"
+)
+
+// ColorMode controls ANSI coloring of failure messages.
+type ColorMode int
+
+const (
+	// ColorAuto colors failure messages if standard output is a terminal,
+	// unless the NO_COLOR environment variable is set. It is the default.
+	ColorAuto ColorMode = iota
+	// ColorAlways always colors failure messages.
+	ColorAlways
+	// ColorNever never colors failure messages.
+	ColorNever
+)
+
+// ANSI escape sequences used for coloring.
+const (
+	ansiRed   = "\x1b[31m"
+	ansiGreen = "\x1b[32m"
+	ansiReset = "\x1b[0m"
+)
+
+// colorOf returns the color mode in effect for t: that of the outermost
+// Asserter setting one, or else the package-level setting.
+func colorOf(t internal.T) ColorMode {
+	for _, a := range asserterOf(t) {
+		if a.color != nil {
+			return *a.color
+		}
+	}
+	return currentSettings().Color
+}
+
+// colorEnabled reports whether failure messages reported through t should
+// be colored.
+func colorEnabled(t internal.T) bool {
+	switch colorOf(t) {
+	case ColorAlways:
+		return true
+	case ColorNever:
+		return false
+	}
+	if os.Getenv("NO_COLOR") != "" || os.Getenv("TERM") == "dumb" {
+		return false
+	}
+	fi, err := os.Stdout.Stat()
+	return err == nil && fi.Mode()&os.ModeCharDevice != 0
+}
+
+// colorize colors a failure message: the value after the "got:" label and
+// diff lines starting with "+ " in red, and the value after the "expect:"
+// label and diff lines starting with "- " in green, matching the "-expect
+// +got" orientation of the diffs in this package.
+func colorize(str string) string {
+	lines := strings.Split(str, "\n")
+	for i, line := range lines {
+		switch {
+		case strings.HasPrefix(line, "    got: "):
+			lines[i] = "    got: " + ansiRed + line[len("    got: "):] + ansiReset
+		case strings.HasPrefix(line, " expect: "):
+			lines[i] = " expect: " + ansiGreen + line[len(" expect: "):] + ansiReset
+		case strings.HasPrefix(line, "+ "):
+			lines[i] = ansiRed + line + ansiReset
+		case strings.HasPrefix(line, "- "):
+			lines[i] = ansiGreen + line + ansiReset
+		}
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,83 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert_test
+
+import (
+	"testing"
+
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/internal/mock"
+)
+
+func TestColor(t *testing.T) {
+	t.Run("always", func(t *testing.T) {
+		defer assert.Configure(func(s *assert.Settings) {
+			s.Color = assert.ColorAlways
+		})()
+		runCase(t, func(g *mock.MockT) {
+			g.EXPECT().Error([]interface{}{"strings not equal:\n" +
+				"    got: \x1b[31m(string) \"a\"\x1b[0m\n" +
+				" expect: \x1b[32m(string) \"b\"\x1b[0m\n" +
+				"message: colored"})
+			assert.ThatString(g, "a").Equal("b", "colored")
+		})
+	})
+	t.Run("never", func(t *testing.T) {
+		defer assert.Configure(func(s *assert.Settings) {
+			s.Color = assert.ColorNever
+		})()
+		runCase(t, func(g *mock.MockT) {
+			g.EXPECT().Error([]interface{}{`strings not equal:
+    got: (string) "a"
+ expect: (string) "b"`})
+			assert.ThatString(g, "a").Equal("b")
+		})
+	})
+	t.Run("NO_COLOR", func(t *testing.T) {
+		defer assert.Configure(func(s *assert.Settings) {
+			s.Color = assert.ColorAuto
+		})()
+		t.Setenv("NO_COLOR", "1")
+		runCase(t, func(g *mock.MockT) {
+			g.EXPECT().Error([]interface{}{`strings not equal:
+    got: (string) "a"
+ expect: (string) "b"`})
+			assert.ThatString(g, "a").Equal("b")
+		})
+	})
+	t.Run("asserter", func(t *testing.T) {
+		defer assert.Configure(func(s *assert.Settings) {
+			s.Color = assert.ColorNever
+		})()
+		runCase(t, func(g *mock.MockT) {
+			g.EXPECT().Error([]interface{}{"strings not equal:\n" +
+				"   diff: (-expect +got)\n" +
+				"  a\n" +
+				"\x1b[32m- c\x1b[0m\n" +
+				"\x1b[31m+ b\x1b[0m"})
+			a := assert.New(g).WithColor(assert.ColorAlways)
+			assert.ThatString(a, "a\nb").Equal("a\nc")
+		})
+		runCase(t, func(g *mock.MockT) {
+			g.EXPECT().Error([]interface{}{`strings not equal:
+    got: (string) "a"
+ expect: (string) "b"`})
+			inner := assert.New(g).WithColor(assert.ColorAlways)
+			assert.ThatString(assert.New(inner).WithColor(assert.ColorNever), "a").Equal("b")
+		})
+	})
+}
@@ -0,0 +1,166 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package compare provides a library of reusable comparisons for
+// assert.Check:
+//
+//	assert.Check(t, compare.Equal(got, 3))
+//	assert.Check(t, compare.ErrorContains(err, "not found"))
+package compare
+
+import (
+	"errors"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/lvan100/go-assert"
+)
+
+// Equal compares got and expect with ==.
+func Equal[T comparable](got, expect T) assert.Comparison {
+	return func() assert.Result {
+		if got != expect {
+			return assert.ResultFailure("values not equal:\n    got: (%T) %v\n expect: (%T) %v", got, got, expect, expect)
+		}
+		return assert.ResultSuccess()
+	}
+}
+
+// DeepEqual compares got and expect with reflect.DeepEqual.
+func DeepEqual(got, expect interface{}) assert.Comparison {
+	return func() assert.Result {
+		if !reflect.DeepEqual(got, expect) {
+			return assert.ResultFailure("values not deep equal:\n    got: (%T) %v\n expect: (%T) %v", got, got, expect, expect)
+		}
+		return assert.ResultSuccess()
+	}
+}
+
+// Nil checks that v is nil, including typed nil pointers, slices, maps,
+// channels, functions and interfaces.
+func Nil(v interface{}) assert.Comparison {
+	return func() assert.Result {
+		if !isNil(v) {
+			return assert.ResultFailure("got (%T) %v but expect nil", v, v)
+		}
+		return assert.ResultSuccess()
+	}
+}
+
+// Len checks that v, a string, slice, array, map or channel, has length n.
+func Len(v interface{}, n int) assert.Comparison {
+	return func() assert.Result {
+		rv := reflect.ValueOf(v)
+		switch rv.Kind() {
+		case reflect.String, reflect.Slice, reflect.Array, reflect.Map, reflect.Chan:
+		default:
+			return assert.ResultFailure("(%T) %v has no length", v, v)
+		}
+		if l := rv.Len(); l != n {
+			return assert.ResultFailure("got length %d but expect %d", l, n)
+		}
+		return assert.ResultSuccess()
+	}
+}
+
+// Contains checks that s contains substr.
+func Contains(s, substr string) assert.Comparison {
+	return func() assert.Result {
+		if !strings.Contains(s, substr) {
+			return assert.ResultFailure("%q does not contain %q", s, substr)
+		}
+		return assert.ResultSuccess()
+	}
+}
+
+// Regexp checks that s matches the regular expression expr.
+func Regexp(s, expr string) assert.Comparison {
+	return func() assert.Result {
+		ok, err := regexp.MatchString(expr, s)
+		if err != nil {
+			return assert.ResultFailure("invalid pattern %q: %v", expr, err)
+		}
+		if !ok {
+			return assert.ResultFailure("%q does not match %q", s, expr)
+		}
+		return assert.ResultSuccess()
+	}
+}
+
+// ErrorContains checks that err is not nil and its message contains substr.
+func ErrorContains(err error, substr string) assert.Comparison {
+	return func() assert.Result {
+		if err == nil {
+			return assert.ResultFailure("got nil but expect an error containing %q", substr)
+		}
+		if !strings.Contains(err.Error(), substr) {
+			return assert.ResultFailure("error %q does not contain %q", err.Error(), substr)
+		}
+		return assert.ResultSuccess()
+	}
+}
+
+// ErrorIs checks that errors.Is(err, target) holds.
+func ErrorIs(err, target error) assert.Comparison {
+	return func() assert.Result {
+		if !errors.Is(err, target) {
+			return assert.ResultFailure("got error %v but expect it to wrap %v", err, target)
+		}
+		return assert.ResultSuccess()
+	}
+}
+
+// Panics checks that fn panics.
+func Panics(fn func()) assert.Comparison {
+	return func() (r assert.Result) {
+		defer func() {
+			if recover() == nil {
+				r = assert.ResultFailure("did not panic")
+			}
+		}()
+		fn()
+		return assert.ResultSuccess()
+	}
+}
+
+// All checks that every comparison in cs holds, reporting the failure
+// messages of those that do not.
+func All(cs ...assert.Comparison) assert.Comparison {
+	return func() assert.Result {
+		var msgs []string
+		for _, c := range cs {
+			if r := c(); !r.Success() {
+				msgs = append(msgs, r.Message())
+			}
+		}
+		if len(msgs) > 0 {
+			return assert.ResultFailure("%s", strings.Join(msgs, "\n"))
+		}
+		return assert.ResultSuccess()
+	}
+}
+
+func isNil(v interface{}) bool {
+	if v == nil {
+		return true
+	}
+	switch rv := reflect.ValueOf(v); rv.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Map, reflect.Chan, reflect.Func, reflect.Interface, reflect.UnsafePointer:
+		return rv.IsNil()
+	}
+	return false
+}
@@ -0,0 +1,69 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package compare_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/compare"
+)
+
+func TestCompare(t *testing.T) {
+	errNotFound := errors.New("not found")
+	var nilMap map[string]int
+
+	for _, c := range []assert.Comparison{
+		compare.Equal(3, 3),
+		compare.DeepEqual([]int{1, 2}, []int{1, 2}),
+		compare.Nil(nil),
+		compare.Nil(nilMap),
+		compare.Len("abc", 3),
+		compare.Len(map[int]int{1: 1}, 1),
+		compare.Contains("hello", "ell"),
+		compare.Regexp("abc123", `^[a-z]+\d+$`),
+		compare.ErrorContains(errNotFound, "found"),
+		compare.ErrorIs(fmt.Errorf("get: %w", errNotFound), errNotFound),
+		compare.Panics(func() { panic("boom") }),
+		compare.All(compare.Equal(1, 1), compare.Contains("ab", "b")),
+	} {
+		r := c()
+		assert.True(t, r.Success(), r.Message())
+	}
+
+	for _, tc := range []struct {
+		c   assert.Comparison
+		msg string
+	}{
+		{compare.Equal("a", "b"), "values not equal:\n    got: (string) a\n expect: (string) b"},
+		{compare.Nil(1), "got (int) 1 but expect nil"},
+		{compare.Len(1, 1), "(int) 1 has no length"},
+		{compare.Len([]int{1}, 2), "got length 1 but expect 2"},
+		{compare.Contains("hello", "x"), `"hello" does not contain "x"`},
+		{compare.Regexp("abc", `\d`), `"abc" does not match "\\d"`},
+		{compare.ErrorContains(nil, "x"), `got nil but expect an error containing "x"`},
+		{compare.ErrorIs(errors.New("a"), errNotFound), "got error a but expect it to wrap not found"},
+		{compare.Panics(func() {}), "did not panic"},
+		{compare.All(compare.Equal(1, 2), compare.Nil(1)), "values not equal:\n    got: (int) 1\n expect: (int) 2\ngot (int) 1 but expect nil"},
+	} {
+		r := tc.c()
+		assert.False(t, r.Success())
+		assert.ThatString(t, r.Message()).Equal(tc.msg)
+	}
+}
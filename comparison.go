@@ -0,0 +1,76 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"fmt"
+
+	"github.com/lvan100/go-assert/internal"
+)
+
+// Result is the outcome of a Comparison: a success, or a failure carrying
+// the message to report.
+type Result struct {
+	failed  bool
+	message string
+}
+
+// ResultSuccess returns the Result of a comparison that holds.
+func ResultSuccess() Result {
+	return Result{}
+}
+
+// ResultFailure returns the Result of a comparison that does not hold, with
+// the failure message formatted from format and args.
+func ResultFailure(format string, args ...interface{}) Result {
+	return Result{failed: true, message: fmt.Sprintf(format, args...)}
+}
+
+// Success reports whether the comparison holds.
+func (r Result) Success() bool {
+	return !r.failed
+}
+
+// Message returns the failure message, empty for a success.
+func (r Result) Message() string {
+	return r.message
+}
+
+// Comparison is a reusable comparison evaluated by Check. The compare
+// package provides a library of them; custom ones are plain functions:
+//
+//	func IsEven(n int) assert.Comparison {
+//		return func() assert.Result {
+//			if n%2 != 0 {
+//				return assert.ResultFailure("got %d but expect even", n)
+//			}
+//			return assert.ResultSuccess()
+//		}
+//	}
+type Comparison func() Result
+
+// Check evaluates the comparison c and reports its failure message if it
+// does not hold.
+func Check(t internal.T, c Comparison, msg ...interface{}) bool {
+	t.Helper()
+	defer track(t, nil)()
+	if r := c(); !r.Success() {
+		fail(t, r.Message(), msg...)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,51 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert_test
+
+import (
+	"testing"
+
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/compare"
+	"github.com/lvan100/go-assert/internal/mock"
+)
+
+func isEven(n int) assert.Comparison {
+	return func() assert.Result {
+		if n%2 != 0 {
+			return assert.ResultFailure("got %d but expect even", n)
+		}
+		return assert.ResultSuccess()
+	}
+}
+
+func TestCheck(t *testing.T) {
+	runCase(t, func(g *mock.MockT) {
+		assert.Check(g, isEven(2))
+		assert.Check(g, compare.Equal("a", "a"))
+	})
+
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"got 3 but expect even\nmessage: index"})
+		assert.Check(g, isEven(3), "index")
+	})
+
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"values not equal:\n    got: (int) 1\n expect: (int) 2"})
+		assert.Check(g, compare.Equal(1, 2))
+	})
+}
@@ -0,0 +1,434 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package testify offers assertion functions with the signatures, argument
+// order and semantics of github.com/stretchr/testify/assert, implemented on
+// top of package assert, so that a codebase can migrate file by file by
+// only changing the import path:
+//
+//	import assert "github.com/lvan100/go-assert/compat/testify"
+//
+//	assert.Equal(t, expected, actual, "case %d", i)
+//
+// Failure messages are those of package assert. Every function returns
+// whether the assertion passed.
+package testify
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/compare"
+	"github.com/lvan100/go-assert/internal"
+)
+
+// TestingT is the test handler interface, which *testing.T implements.
+type TestingT interface {
+	Helper()
+	Error(args ...interface{})
+	Errorf(format string, args ...interface{})
+	Fatal(args ...interface{})
+	Fatalf(format string, args ...interface{})
+	Logf(format string, args ...interface{})
+	Name() string
+	Cleanup(fn func())
+	Skip(args ...interface{})
+}
+
+// tracker is a test handler noting whether an assertion failed.
+type tracker struct {
+	internal.T
+	failed bool
+}
+
+func (t *tracker) Error(args ...interface{}) {
+	t.T.Helper()
+	t.failed = true
+	t.T.Error(args...)
+}
+
+func (t *tracker) Errorf(format string, args ...interface{}) {
+	t.T.Helper()
+	t.failed = true
+	t.T.Errorf(format, args...)
+}
+
+func (t *tracker) Fatal(args ...interface{}) {
+	t.T.Helper()
+	t.failed = true
+	t.T.Fatal(args...)
+}
+
+func (t *tracker) Fatalf(format string, args ...interface{}) {
+	t.T.Helper()
+	t.failed = true
+	t.T.Fatalf(format, args...)
+}
+
+// Unwrap returns the wrapped test handler.
+func (t *tracker) Unwrap() internal.T {
+	return t.T
+}
+
+// run makes the assertions of fn through t and reports whether they passed.
+func run(t TestingT, fn func(t internal.T)) bool {
+	t.Helper()
+	r := &tracker{T: t}
+	fn(r)
+	return !r.failed
+}
+
+// message turns testify's msgAndArgs into the message of an assertion: a
+// single value is printed, several are formatted with the first as format.
+func message(msgAndArgs []interface{}) []interface{} {
+	switch len(msgAndArgs) {
+	case 0:
+		return nil
+	case 1:
+		if s, ok := msgAndArgs[0].(string); ok {
+			return []interface{}{s}
+		}
+		return []interface{}{fmt.Sprintf("%+v", msgAndArgs[0])}
+	}
+	if format, ok := msgAndArgs[0].(string); ok {
+		return []interface{}{fmt.Sprintf(format, msgAndArgs[1:]...)}
+	}
+	return []interface{}{fmt.Sprint(msgAndArgs...)}
+}
+
+// Equal asserts that expected and actual are deeply equal. As with testify,
+// byte slices are compared by content.
+func Equal(t TestingT, expected, actual interface{}, msgAndArgs ...interface{}) bool {
+	t.Helper()
+	return run(t, func(t internal.T) {
+		// so that nil and empty byte slices are equal
+		if _, ok := expected.([]byte); ok && objectsAreEqual(expected, actual) {
+			return
+		}
+		assert.That(t, actual).Equal(expected, message(msgAndArgs)...)
+	})
+}
+
+// NotEqual asserts that expected and actual are not deeply equal.
+func NotEqual(t TestingT, expected, actual interface{}, msgAndArgs ...interface{}) bool {
+	t.Helper()
+	return run(t, func(t internal.T) {
+		assert.That(t, actual).NotEqual(expected, message(msgAndArgs)...)
+	})
+}
+
+// True asserts that value is true.
+func True(t TestingT, value bool, msgAndArgs ...interface{}) bool {
+	t.Helper()
+	return run(t, func(t internal.T) {
+		assert.True(t, value, message(msgAndArgs)...)
+	})
+}
+
+// False asserts that value is false.
+func False(t TestingT, value bool, msgAndArgs ...interface{}) bool {
+	t.Helper()
+	return run(t, func(t internal.T) {
+		assert.False(t, value, message(msgAndArgs)...)
+	})
+}
+
+// Nil asserts that object is nil, including typed nil pointers, maps,
+// slices, channels and functions.
+func Nil(t TestingT, object interface{}, msgAndArgs ...interface{}) bool {
+	t.Helper()
+	return run(t, func(t internal.T) {
+		assert.Nil(t, object, message(msgAndArgs)...)
+	})
+}
+
+// NotNil asserts that object is not nil.
+func NotNil(t TestingT, object interface{}, msgAndArgs ...interface{}) bool {
+	t.Helper()
+	return run(t, func(t internal.T) {
+		assert.NotNil(t, object, message(msgAndArgs)...)
+	})
+}
+
+// NoError asserts that err is nil.
+func NoError(t TestingT, err error, msgAndArgs ...interface{}) bool {
+	t.Helper()
+	return run(t, func(t internal.T) {
+		assert.ThatError(t, err).IsNil(message(msgAndArgs)...)
+	})
+}
+
+// Error asserts that err is not nil.
+func Error(t TestingT, err error, msgAndArgs ...interface{}) bool {
+	t.Helper()
+	return run(t, func(t internal.T) {
+		assert.ThatError(t, err).IsNotNil(message(msgAndArgs)...)
+	})
+}
+
+// ErrorContains asserts that err is not nil and its message contains
+// contains.
+func ErrorContains(t TestingT, err error, contains string, msgAndArgs ...interface{}) bool {
+	t.Helper()
+	return run(t, func(t internal.T) {
+		assert.ThatError(t, err).ContainsMessage(contains, message(msgAndArgs)...)
+	})
+}
+
+// Len asserts that object, a string, array, slice, map or channel, has the
+// given length.
+func Len(t TestingT, object interface{}, length int, msgAndArgs ...interface{}) bool {
+	t.Helper()
+	return run(t, func(t internal.T) {
+		switch v := reflect.ValueOf(object); v.Kind() {
+		case reflect.String, reflect.Array, reflect.Slice, reflect.Map, reflect.Chan:
+			assert.ThatNumber(t, v.Len()).Equal(length, message(msgAndArgs)...)
+		default:
+			str := fmt.Sprintf("unsupported value (%T) %v for len()", object, object)
+			if m := message(msgAndArgs); m != nil {
+				str += fmt.Sprintf(", %v", m[0])
+			}
+			assert.True(t, false, str)
+		}
+	})
+}
+
+// Contains asserts that s contains contains: as a substring if s is a
+// string, as an element if s is an array or a slice, and as a key if s is
+// a map.
+func Contains(t TestingT, s, contains interface{}, msgAndArgs ...interface{}) bool {
+	t.Helper()
+	return run(t, func(t internal.T) {
+		switch v := reflect.ValueOf(s); v.Kind() {
+		case reflect.String:
+			str, ok := contains.(string)
+			if !ok {
+				assert.That(t, contains).TypeOf(str, message(msgAndArgs)...)
+				return
+			}
+			assert.ThatString(t, v.String()).Contains(str, message(msgAndArgs)...)
+		case reflect.Map:
+			assert.That(t, contains).InMapKeys(s, message(msgAndArgs)...)
+		default:
+			assert.That(t, contains).InSlice(s, message(msgAndArgs)...)
+		}
+	})
+}
+
+// NotContains asserts that s does not contain contains, see Contains. As
+// with testify, it fails if s is not a string, array, slice or map.
+func NotContains(t TestingT, s, contains interface{}, msgAndArgs ...interface{}) bool {
+	t.Helper()
+	return run(t, func(t internal.T) {
+		assert.Check(t, func() assert.Result {
+			ok, found := containsElement(s, contains)
+			if !ok {
+				return assert.ResultFailure("unsupported expect value (%T) %v", s, s)
+			}
+			if found {
+				return assert.ResultFailure("got (%T) %v is in (%T) %v", contains, contains, s, s)
+			}
+			return assert.ResultSuccess()
+		}, message(msgAndArgs)...)
+	})
+}
+
+// containsElement reports whether list contains element as testify does:
+// a string its text, an array or slice an element and a map a key equal to
+// it, see objectsAreEqual. ok is false if list is none of these.
+func containsElement(list, element interface{}) (ok, found bool) {
+	switch v := reflect.ValueOf(list); v.Kind() {
+	case reflect.String:
+		return true, strings.Contains(v.String(), reflect.ValueOf(element).String())
+	case reflect.Map:
+		for _, key := range v.MapKeys() {
+			if objectsAreEqual(key.Interface(), element) {
+				return true, true
+			}
+		}
+		return true, false
+	case reflect.Array, reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			if objectsAreEqual(v.Index(i).Interface(), element) {
+				return true, true
+			}
+		}
+		return true, false
+	}
+	return false, false
+}
+
+// objectsAreEqual reports whether expected and actual are equal as testify
+// compares them: byte slices by content, so that nil and empty ones are
+// equal, and other values with reflect.DeepEqual.
+func objectsAreEqual(expected, actual interface{}) bool {
+	if expected == nil || actual == nil {
+		return expected == actual
+	}
+	exp, ok := expected.([]byte)
+	if !ok {
+		return reflect.DeepEqual(expected, actual)
+	}
+	act, ok := actual.([]byte)
+	if !ok {
+		return false
+	}
+	return bytes.Equal(exp, act)
+}
+
+// isEmpty reports whether object is empty as testify defines it: nil, a
+// zero value, an empty string, array, slice, map or channel, or a pointer
+// to an empty value.
+func isEmpty(object interface{}) bool {
+	if object == nil {
+		return true
+	}
+	switch v := reflect.ValueOf(object); v.Kind() {
+	case reflect.String, reflect.Array, reflect.Slice, reflect.Map, reflect.Chan:
+		return v.Len() == 0
+	case reflect.Ptr:
+		if v.IsNil() {
+			return true
+		}
+		return isEmpty(v.Elem().Interface())
+	default:
+		return v.IsZero()
+	}
+}
+
+// Empty asserts that object is empty: nil, a zero value, an empty string,
+// array, slice, map or channel, or a pointer to an empty value.
+func Empty(t TestingT, object interface{}, msgAndArgs ...interface{}) bool {
+	t.Helper()
+	return run(t, func(t internal.T) {
+		assert.Check(t, func() assert.Result {
+			if !isEmpty(object) {
+				return assert.ResultFailure("got (%T) %v but expect empty", object, object)
+			}
+			return assert.ResultSuccess()
+		}, message(msgAndArgs)...)
+	})
+}
+
+// NotEmpty asserts that object is not empty, see Empty.
+func NotEmpty(t TestingT, object interface{}, msgAndArgs ...interface{}) bool {
+	t.Helper()
+	return run(t, func(t internal.T) {
+		assert.Check(t, func() assert.Result {
+			if isEmpty(object) {
+				return assert.ResultFailure("got (%T) %v but expect not empty", object, object)
+			}
+			return assert.ResultSuccess()
+		}, message(msgAndArgs)...)
+	})
+}
+
+// Zero asserts that i is the zero value for its type.
+func Zero(t TestingT, i interface{}, msgAndArgs ...interface{}) bool {
+	t.Helper()
+	return run(t, func(t internal.T) {
+		assert.That(t, i).IsZero(message(msgAndArgs)...)
+	})
+}
+
+// NotZero asserts that i is not the zero value for its type.
+func NotZero(t TestingT, i interface{}, msgAndArgs ...interface{}) bool {
+	t.Helper()
+	return run(t, func(t internal.T) {
+		assert.That(t, i).NotZero(message(msgAndArgs)...)
+	})
+}
+
+// ErrorIs asserts that err wraps target, as errors.Is reports.
+func ErrorIs(t TestingT, err, target error, msgAndArgs ...interface{}) bool {
+	t.Helper()
+	return run(t, func(t internal.T) {
+		assert.Check(t, compare.ErrorIs(err, target), message(msgAndArgs)...)
+	})
+}
+
+// EqualError asserts that err is not nil and its message is errString.
+func EqualError(t TestingT, err error, errString string, msgAndArgs ...interface{}) bool {
+	t.Helper()
+	return run(t, func(t internal.T) {
+		if assert.ThatError(t, err).IsNotNil(message(msgAndArgs)...) {
+			assert.ThatString(t, err.Error()).Equal(errString, message(msgAndArgs)...)
+		}
+	})
+}
+
+// Regexp asserts that str matches the regular expression rx, a string or
+// a *regexp.Regexp.
+func Regexp(t TestingT, rx interface{}, str interface{}, msgAndArgs ...interface{}) bool {
+	t.Helper()
+	return run(t, func(t internal.T) {
+		expr := fmt.Sprint(rx)
+		assert.ThatString(t, fmt.Sprint(str)).Matches(expr, message(msgAndArgs)...)
+	})
+}
+
+// JSONEq asserts that expected and actual are equivalent JSON documents.
+func JSONEq(t TestingT, expected, actual string, msgAndArgs ...interface{}) bool {
+	t.Helper()
+	return run(t, func(t internal.T) {
+		assert.ThatString(t, actual).JSONEqual(expected, message(msgAndArgs)...)
+	})
+}
+
+// Same asserts that expected and actual are pointers of the same type to
+// the same address. As with testify, it fails if either is not a pointer.
+func Same(t TestingT, expected, actual interface{}, msgAndArgs ...interface{}) bool {
+	t.Helper()
+	return run(t, func(t internal.T) {
+		if reflect.ValueOf(expected).Kind() != reflect.Ptr || reflect.ValueOf(actual).Kind() != reflect.Ptr {
+			assert.Check(t, func() assert.Result {
+				return assert.ResultFailure("got (%T) %v and (%T) %v but expect both pointers", expected, expected, actual, actual)
+			}, message(msgAndArgs)...)
+			return
+		}
+		assert.That(t, actual).Same(expected, message(msgAndArgs)...)
+	})
+}
+
+// IsType asserts that object has the same type as expectedType.
+func IsType(t TestingT, expectedType, object interface{}, msgAndArgs ...interface{}) bool {
+	t.Helper()
+	return run(t, func(t internal.T) {
+		assert.That(t, object).IsType(expectedType, message(msgAndArgs)...)
+	})
+}
+
+// Panics asserts that f panics.
+func Panics(t TestingT, f func(), msgAndArgs ...interface{}) bool {
+	t.Helper()
+	return run(t, func(t internal.T) {
+		assert.Check(t, compare.Panics(f), message(msgAndArgs)...)
+	})
+}
+
+// Fail reports a failure with failureMessage.
+func Fail(t TestingT, failureMessage string, msgAndArgs ...interface{}) bool {
+	t.Helper()
+	return run(t, func(t internal.T) {
+		if m := message(msgAndArgs); m != nil {
+			failureMessage += fmt.Sprintf("\nmessage: %v", m[0])
+		}
+		assert.Fail(t, "%s", failureMessage)
+	})
+}
@@ -0,0 +1,161 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package testify_test
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/compat/testify"
+	"github.com/lvan100/go-assert/internal/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestMain(m *testing.M) {
+	assert.Configure(func(s *assert.Settings) {
+		s.Color = assert.ColorNever
+	})
+	os.Exit(m.Run())
+}
+
+func runCase(t *testing.T, f func(g *mock.MockT)) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	g := mock.NewMockT(ctrl)
+	g.EXPECT().Helper().AnyTimes()
+	f(g)
+}
+
+func TestPassing(t *testing.T) {
+	runCase(t, func(g *mock.MockT) {
+		assert.True(t, testify.Equal(g, []int{1}, []int{1}))
+		assert.True(t, testify.Equal(g, []byte(nil), []byte{}))
+		assert.True(t, testify.Equal(g, []byte("ab"), []byte("ab")))
+		assert.True(t, testify.NotEqual(g, 1, 2))
+		assert.True(t, testify.True(g, true))
+		assert.True(t, testify.False(g, false))
+		assert.True(t, testify.Nil(g, (*int)(nil)))
+		assert.True(t, testify.NotNil(g, 1))
+		assert.True(t, testify.NoError(g, nil))
+		assert.True(t, testify.Error(g, errors.New("x")))
+		assert.True(t, testify.ErrorContains(g, errors.New("no such file"), "such"))
+		assert.True(t, testify.Len(g, map[int]int{1: 1}, 1))
+		assert.True(t, testify.Len(g, "abc", 3))
+		assert.True(t, testify.Contains(g, "hello", "ell"))
+		assert.True(t, testify.Contains(g, []string{"a", "b"}, "b"))
+		assert.True(t, testify.Contains(g, map[string]int{"a": 1}, "a"))
+		assert.True(t, testify.NotContains(g, "hello", "x"))
+		assert.True(t, testify.NotContains(g, map[string]int{"a": 1}, "b"))
+		assert.True(t, testify.NotContains(g, []interface{}{1, "a"}, 2))
+		assert.True(t, testify.NotContains(g, [2]int{1, 2}, 3))
+		assert.True(t, testify.NotContains(g, "hello", 1))
+		assert.True(t, testify.Empty(g, ""))
+		assert.True(t, testify.Empty(g, []int{}))
+		assert.True(t, testify.NotEmpty(g, map[int]int{1: 1}))
+		assert.True(t, testify.Zero(g, 0))
+		assert.True(t, testify.NotZero(g, "a"))
+		assert.True(t, testify.ErrorIs(g, fmt.Errorf("wrap: %w", os.ErrNotExist), os.ErrNotExist))
+		assert.True(t, testify.EqualError(g, errors.New("boom"), "boom"))
+		assert.True(t, testify.Regexp(g, regexp.MustCompile(`^h.*o$`), "hello"))
+		assert.True(t, testify.JSONEq(g, `{"a": 1, "b": 2}`, `{"b":2,"a":1}`))
+		p := new(int)
+		assert.True(t, testify.Same(g, p, p))
+		assert.True(t, testify.IsType(g, 0, 1))
+		assert.True(t, testify.Panics(g, func() { panic("x") }))
+	})
+}
+
+func TestFailing(t *testing.T) {
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"got (int) 2 but expect (int) 1\nmessage: case 3: x"})
+		assert.False(t, testify.Equal(g, 1, 2, "case %d: %s", 3, "x"))
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error(gomock.Any())
+		assert.False(t, testify.Equal(g, []byte("a"), []byte("b")))
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"expect nil error, got: boom\nmessage: {a:1}"})
+		assert.False(t, testify.NoError(g, errors.New("boom"), struct{ a int }{1}))
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"got (int) 2 but expect (int) 3"})
+		assert.False(t, testify.Len(g, []int{1, 2}, 3))
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"got false but expect true\nmessage: unsupported value (int) 5 for len()"})
+		assert.False(t, testify.Len(g, 5, 1))
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"got (string) c is not in ([]string) [a b]"})
+		assert.False(t, testify.Contains(g, []string{"a", "b"}, "c"))
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"got (string) b is in ([]string) [a b]"})
+		assert.False(t, testify.NotContains(g, []string{"a", "b"}, "b"))
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"got ([]int) [1] but expect empty"})
+		assert.False(t, testify.Empty(g, []int{1}))
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"got (*int) <nil> but expect not empty"})
+		assert.False(t, testify.NotEmpty(g, (*int)(nil)))
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"got error boom but expect it to wrap file does not exist"})
+		assert.False(t, testify.ErrorIs(g, errors.New("boom"), os.ErrNotExist))
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"expect not nil error"})
+		assert.False(t, testify.EqualError(g, nil, "boom"))
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"did not panic"})
+		assert.False(t, testify.Panics(g, func() {}))
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"not implemented\nmessage: case 1"})
+		assert.False(t, testify.Fail(g, "not implemented", "case %d", 1))
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"got (string) ell is in (string) hello"})
+		assert.False(t, testify.NotContains(g, "hello", "ell"))
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"got (string) a is in (map[string]int) map[a:1]"})
+		assert.False(t, testify.NotContains(g, map[string]int{"a": 1}, "a"))
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"unsupported expect value (int) 5"})
+		g.EXPECT().Error([]interface{}{"unsupported expect value (<nil>) <nil>"})
+		assert.False(t, testify.NotContains(g, 5, 1))
+		assert.False(t, testify.NotContains(g, nil, 1))
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"got (int) 1 and (int) 1 but expect both pointers"})
+		assert.False(t, testify.Same(g, 1, 1))
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error(gomock.Any())
+		assert.False(t, testify.Same(g, new(int), new(int)))
+	})
+}
@@ -0,0 +1,265 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/lvan100/go-assert/internal"
+)
+
+// counters holds the number of assertions run by each test that requires
+// assertions, keyed by its innermost test handler.
+var counters = struct {
+	sync.Mutex
+	m map[internal.T]*testCounter
+	n atomic.Int32 // len(m), read without the lock
+}{m: make(map[internal.T]*testCounter)}
+
+// testCounter counts the assertions or failures of a test, including those
+// of its subtests, such as the cases run by Table: the subtests are found
+// by name, as testing names them after their parent.
+type testCounter struct {
+	atomic.Int64
+	t    internal.T // the innermost test handler of the test
+	name string
+}
+
+// newTestCounter returns a counter for the test of the innermost test
+// handler t.
+func newTestCounter(t internal.T) *testCounter {
+	return &testCounter{t: t, name: t.Name()}
+}
+
+// counts reports whether c counts the events of the test of the innermost
+// test handler t, whose name is returned by name: whether it is the test
+// of c or one of its subtests.
+func (c *testCounter) counts(t internal.T, name func() string) bool {
+	if c.t == t {
+		return true
+	}
+	return c.name != "" && strings.HasPrefix(name(), c.name+"/")
+}
+
+// nameOf returns a function returning the name of t, calling its Name
+// method once at most.
+func nameOf(t internal.T) func() string {
+	return sync.OnceValue(t.Name)
+}
+
+// RequireAssertions makes the test fail at its end if fewer than n
+// assertions ran from now on. RequireAssertions(t, 1) guards against tests
+// that silently skip their checks, e.g. because of an early return or an
+// empty table of cases. Assertions made through an Asserter wrapping t
+// and in subtests of t, such as the cases of Table, count for t.
+func RequireAssertions(t internal.T, n int) {
+	t.Helper()
+	counter := assertionCounter(t)
+	start := counter.Load()
+	t.Cleanup(func() {
+		t.Helper()
+		if got := counter.Load() - start; got < int64(n) {
+			str := fmt.Sprintf(`too few assertions:
+    got: %d
+ expect: at least %d`, got, n)
+			fail(t, str)
+		}
+	})
+}
+
+// assertionCounter returns the assertion counter of the test of t, starting
+// to count its assertions until it ends.
+func assertionCounter(t internal.T) *testCounter {
+	key := baseT(t)
+	if endless(t) {
+		return newTestCounter(key)
+	}
+	counters.Lock()
+	defer counters.Unlock()
+	if counter, ok := counters.m[key]; ok {
+		return counter
+	}
+	counter := newTestCounter(key)
+	counters.m[key] = counter
+	counters.n.Add(1)
+	t.Cleanup(func() {
+		counters.Lock()
+		defer counters.Unlock()
+		if counters.m[key] == counter {
+			delete(counters.m, key)
+			counters.n.Add(-1)
+		}
+	})
+	return counter
+}
+
+// AssertionStats holds the number of assertions run and failed by a test
+// since Stats was called. Assertions made through an Asserter wrapping the
+// test handler and in its subtests count for it.
+type AssertionStats struct {
+	t                              internal.T
+	assertions, failures           *testCounter
+	startAssertions, startFailures int64
+}
+
+// Stats starts counting the assertions of the test of t and returns their
+// statistics, e.g. to catch tests that accidentally run no assertion:
+//
+//	stats := assert.Stats(t).LogSummary()
+//	...
+//	assert.True(t, stats.Assertions() > 0)
+func Stats(t internal.T) *AssertionStats {
+	s := &AssertionStats{
+		t:          t,
+		assertions: assertionCounter(t),
+		failures:   failuresOf(t),
+	}
+	s.startAssertions = s.assertions.Load()
+	s.startFailures = s.failures.Load()
+	return s
+}
+
+// Assertions returns the number of assertions run since Stats was called.
+func (s *AssertionStats) Assertions() int {
+	return int(s.assertions.Load() - s.startAssertions)
+}
+
+// Failures returns the number of assertions failed since Stats was called.
+func (s *AssertionStats) Failures() int {
+	return int(s.failures.Load() - s.startFailures)
+}
+
+// LogSummary logs the statistics at the end of the test, through its
+// Cleanup and Logf methods, e.g. "assertions: 12 run, 1 failed".
+func (s *AssertionStats) LogSummary() *AssertionStats {
+	s.t.Cleanup(func() {
+		s.t.Logf("assertions: %d run, %d failed", s.Assertions(), s.Failures())
+	})
+	return s
+}
+
+// track is called first thing by every assertion, which defers the
+// returned function: it counts the assertion if its test requires
+// assertions, and logs it when it returns without failure if passing
+// assertions are logged, see Settings.LogPasses. Assertions called by other
+// assertions of this package are neither counted nor logged.
+func track(t internal.T, v interface{}) func() {
+	if skipped(t) {
+		return nop
+	}
+	return trackNegation(t, v, trackAssertion(t, v), nil)
+}
+
+// trackResult is track for the assertions returning whether they passed,
+// which name that result ok: when the assertion is an inverted check, ok is
+// set to the outcome of the inversion once it is settled.
+func trackResult(t internal.T, v interface{}, ok *bool) func() {
+	if skipped(t) {
+		return nop
+	}
+	return trackNegation(t, v, trackAssertion(t, v), ok)
+}
+
+// trackNegation wraps the function done returned by trackAssertion so that
+// the inverted check started by the assertion, if any, is settled first,
+// storing its outcome in ok unless nil, and the chain it is part of
+// resumes last.
+func trackNegation(t internal.T, v interface{}, done func(), ok *bool) func() {
+	if n, isNegated := t.(*negatedT); isNegated && n.begin() {
+		// the inversion must be settled before a pass is logged
+		tracked := done
+		done = func() {
+			n.Helper()
+			n.end(v)
+			if ok != nil {
+				*ok = n.failed && !n.misused
+			}
+			tracked()
+		}
+	}
+	if c, isChained := findT[*chainT](t); isChained {
+		end := c.begin()
+		tracked := done
+		done = func() {
+			t.Helper()
+			tracked()
+			end()
+		}
+	}
+	return done
+}
+
+// trackAssertion implements track for the assertion calling it.
+func trackAssertion(t internal.T, v interface{}) func() {
+	counting := counters.n.Load() > 0
+	logging := logPassesOf(t)
+	if !counting && !logging {
+		return nop
+	}
+	pc := make([]uintptr, 2)
+	frames := runtime.CallersFrames(pc[:runtime.Callers(3, pc)])
+	assertion, more := frames.Next()
+	if caller, _ := frames.Next(); more && inPackage(caller.Function) {
+		return nop
+	}
+	if counting {
+		countAssertion(t)
+	}
+	if !logging {
+		return nop
+	}
+	op := opName(strings.TrimPrefix(assertion.Function, pkgPath))
+	failures := failuresOf(t)
+	before := failures.Load()
+	return func() {
+		t.Helper()
+		if failures.Load() == before {
+			logPass(t, op, v)
+		}
+	}
+}
+
+func nop() {}
+
+// countAssertion counts an assertion of the test of t for it and the tests
+// it is a subtest of.
+func countAssertion(t internal.T) {
+	key := baseT(t)
+	name := nameOf(key)
+	counters.Lock()
+	defer counters.Unlock()
+	for _, c := range counters.m {
+		if c.counts(key, name) {
+			c.Add(1)
+		}
+	}
+}
+
+// baseT returns the innermost test handler wrapped by t.
+func baseT(t internal.T) internal.T {
+	for {
+		u, ok := t.(interface{ Unwrap() internal.T })
+		if !ok {
+			return t
+		}
+		t = u.Unwrap()
+	}
+}
@@ -0,0 +1,117 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/lvan100/go-assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRequireAssertions(t *testing.T) {
+	runNamedCase(t, "TestCount", func(ct *namedT) {
+		assert.RequireAssertions(ct, 3)
+		assert.True(ct, true)
+		assert.ThatString(ct, "abc").HasPrefix("a").HasSuffix("c")
+	})
+
+	runNamedCase(t, "TestCount", func(ct *namedT) {
+		ct.EXPECT().Error([]interface{}{`too few assertions:
+    got: 0
+ expect: at least 1`})
+		assert.RequireAssertions(ct, 1)
+	})
+
+	// assertions that delegate to other assertions count once, and
+	// assertions made through an Asserter count for the wrapped test.
+	runNamedCase(t, "TestCount", func(ct *namedT) {
+		ct.EXPECT().Error([]interface{}{`case 1: too few assertions:
+    got: 2
+ expect: at least 3`})
+		a := assert.New(ct).WithContext("case %d", 1)
+		assert.RequireAssertions(a, 3)
+		req := httptest.NewRequest(http.MethodGet, "/users?id=7", nil)
+		assert.ThatRequest(a, req).QueryParam("id", "7")
+		assert.That(a, 1).Equal(1)
+	})
+}
+
+func TestRequireAssertions_Subtests(t *testing.T) {
+	// the assertions of subtests count for their parent
+	assert.RequireAssertions(t, 3)
+	assert.Table(t, []int{1, 2}, func(t assert.T, c int) {
+		assert.That(t, c).GreaterThan(0)
+	})
+	t.Run("sub", func(t *testing.T) {
+		assert.True(t, true)
+	})
+
+	runNamedCase(t, "TestCount", func(ct *namedT) {
+		ct.EXPECT().Error([]interface{}{`too few assertions:
+    got: 1
+ expect: at least 2`})
+		assert.RequireAssertions(ct, 2)
+		assert.True(&namedT{MockT: ct.MockT, name: "TestCount/case"}, true)
+		// tests sharing a prefix of the name are not subtests
+		assert.True(&namedT{MockT: ct.MockT, name: "TestCounter"}, true)
+	})
+}
+
+func TestStats(t *testing.T) {
+	runNamedCase(t, "TestStats", func(ct *namedT) {
+		ct.EXPECT().Error(gomock.Any())
+		ct.EXPECT().Logf("assertions: %d run, %d failed", 3, 1)
+		stats := assert.Stats(ct).LogSummary()
+		assert.True(ct, true)
+		assert.ThatString(ct, "abc").HasPrefix("a").HasSuffix("x")
+		assert.ThatNumber(t, stats.Assertions()).Equal(3)
+		assert.ThatNumber(t, stats.Failures()).Equal(1)
+	})
+
+	// statistics only count assertions made after Stats was called, and
+	// share their counter with RequireAssertions.
+	runNamedCase(t, "TestStats", func(ct *namedT) {
+		assert.RequireAssertions(ct, 1)
+		assert.True(ct, true)
+		stats := assert.Stats(ct)
+		assert.ThatNumber(t, stats.Assertions()).Equal(0)
+		assert.False(ct, false)
+		assert.ThatNumber(t, stats.Assertions()).Equal(1)
+		assert.ThatNumber(t, stats.Failures()).Equal(0)
+	})
+
+	// the assertions and failures of subtests count for their parent
+	runNamedCase(t, "TestStats", func(ct *namedT) {
+		ct.EXPECT().Error(gomock.Any())
+		stats := assert.Stats(ct)
+		sub := &namedT{MockT: ct.MockT, name: "TestStats/case_0"}
+		assert.True(sub, true)
+		assert.True(sub, false)
+		assert.ThatNumber(t, stats.Assertions()).Equal(2)
+		assert.ThatNumber(t, stats.Failures()).Equal(1)
+	})
+	t.Run("subtests", func(t *testing.T) {
+		stats := assert.Stats(t)
+		assert.Table(t, []string{"a", "b"}, func(t assert.T, c string) {
+			assert.ThatString(t, c).IsNotEmpty()
+		})
+		assert.ThatNumber(t, stats.Assertions()).Equal(2)
+	})
+}
@@ -0,0 +1,148 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// gzipMagic is the magic number of the gzip format.
+const gzipMagic = "\x1f\x8b"
+
+// decompressor decodes a compression format recognized by its magic number.
+type decompressor struct {
+	magic     []byte
+	newReader func(io.Reader) (io.Reader, error)
+}
+
+var decompressors = struct {
+	sync.RWMutex
+	m map[string]decompressor
+}{m: map[string]decompressor{
+	"gzip": {
+		magic:     []byte(gzipMagic),
+		newReader: func(r io.Reader) (io.Reader, error) { return gzip.NewReader(r) },
+	},
+}}
+
+// RegisterDecompressor registers newReader to decode the compression format
+// named format in Decompressed mode, for content starting with magic. Only
+// gzip is built in; packages such as zstdassert register further formats
+// when imported, so that this package does not depend on their decoders.
+// It returns a function that restores the previous decoder of the format.
+func RegisterDecompressor(format string, magic []byte, newReader func(io.Reader) (io.Reader, error)) (restore func()) {
+	decompressors.Lock()
+	defer decompressors.Unlock()
+	prev, ok := decompressors.m[format]
+	decompressors.m[format] = decompressor{magic: magic, newReader: newReader}
+	return func() {
+		decompressors.Lock()
+		defer decompressors.Unlock()
+		if ok {
+			decompressors.m[format] = prev
+		} else {
+			delete(decompressors.m, format)
+		}
+	}
+}
+
+// detect returns the registered format whose magic number starts head, the
+// longest one if several do, or "" if none does.
+func detect(head []byte) (string, decompressor) {
+	decompressors.RLock()
+	defer decompressors.RUnlock()
+	var (
+		format string
+		found  decompressor
+	)
+	for name, d := range decompressors.m {
+		if len(d.magic) > 0 && bytes.HasPrefix(head, d.magic) && len(d.magic) > len(found.magic) {
+			format, found = name, d
+		}
+	}
+	return format, found
+}
+
+// maxMagicLen returns the length of the longest registered magic number.
+func maxMagicLen() int {
+	decompressors.RLock()
+	defer decompressors.RUnlock()
+	n := 0
+	for _, d := range decompressors.m {
+		n = max(n, len(d.magic))
+	}
+	return n
+}
+
+// decompress detects the compression format of r from its magic bytes and
+// returns a reader of the decoded content together with the format name.
+// Uncompressed content is passed through and the format name is empty.
+func decompress(r io.Reader) (io.Reader, string, error) {
+	br := bufio.NewReader(r)
+	head, _ := br.Peek(maxMagicLen())
+	format, d := detect(head)
+	if format == "" {
+		return br, "", nil
+	}
+	zr, err := d.newReader(br)
+	if err != nil {
+		return nil, format, err
+	}
+	return zr, format, nil
+}
+
+// decompressBytes is like decompress but operates on an in-memory buffer.
+func decompressBytes(b []byte) ([]byte, string, error) {
+	r, format, err := decompress(bytes.NewReader(b))
+	if err != nil {
+		return nil, format, err
+	}
+	if format == "" {
+		return b, "", nil
+	}
+	d, err := io.ReadAll(r)
+	if err != nil {
+		return nil, format, err
+	}
+	return d, format, nil
+}
+
+// compressionNote returns the line appended to failure messages of
+// decompressed content, or "" when the content was not compressed.
+func compressionNote(format string, size int64) string {
+	if format == "" {
+		return ""
+	}
+	return fmt.Sprintf("\ncompressed: %s, %d bytes", format, size)
+}
+
+// countingReader counts the bytes read from the underlying reader.
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,145 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/internal/mock"
+	"github.com/lvan100/go-assert/yamlassert"
+)
+
+func gzipped(s string) []byte {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	_, _ = w.Write([]byte(s))
+	_ = w.Close()
+	return buf.Bytes()
+}
+
+// registerTagged registers a test format for content prefixed with the
+// magic "TAG:", which is decoded by dropping the magic.
+func registerTagged() (restore func()) {
+	return assert.RegisterDecompressor("tagged", []byte("TAG:"), func(r io.Reader) (io.Reader, error) {
+		if _, err := io.CopyN(io.Discard, r, 4); err != nil {
+			return nil, err
+		}
+		return r, nil
+	})
+}
+
+func TestDecompressed_Bytes(t *testing.T) {
+	runCase(t, func(g *mock.MockT) {
+		assert.ThatBytes(g, gzipped("hello")).Decompressed().Equal([]byte("hello"))
+		assert.ThatBytes(g, []byte("hello")).Decompressed().Equal([]byte("hello"))
+		assert.ThatBytes(g, []byte("TAG:hello")).Decompressed().Equal([]byte("TAG:hello"))
+	})
+	runCase(t, func(g *mock.MockT) {
+		b := gzipped("hello")
+		g.EXPECT().Error([]interface{}{fmt.Sprintf(`length mismatch:
+    got: length 5
+ expect: length 6
+compressed: gzip, %d bytes`, len(b))})
+		assert.ThatBytes(g, b).Decompressed().HasLen(6)
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`unable to decompress bytes:
+ format: gzip
+  error: unexpected EOF`})
+		assert.ThatBytes(g, []byte("\x1f\x8b")).Decompressed()
+	})
+}
+
+func TestRegisterDecompressor(t *testing.T) {
+	restore := registerTagged()
+	runCase(t, func(g *mock.MockT) {
+		assert.ThatBytes(g, []byte("TAG:hello")).Decompressed().Equal([]byte("hello"))
+		assert.ThatBytes(g, gzipped("hello")).Decompressed().Equal([]byte("hello"))
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`stream content not equal:
+ offset: 7
+    got: "hello, world!" <EOF>
+ expect: "hello, there!" <EOF>
+compressed: tagged, 17 bytes read`})
+		assert.ThatReader(g, strings.NewReader("TAG:hello, world!")).Decompressed().ContentEqual("hello, there!")
+	})
+	runCase(t, func(g *mock.MockT) {
+		defer assert.RegisterDecompressor("broken", []byte("BAD:"), func(io.Reader) (io.Reader, error) {
+			return nil, errors.New("invalid header")
+		})()
+		g.EXPECT().Error([]interface{}{`unable to decompress bytes:
+ format: broken
+  error: invalid header
+message: broken content`})
+		assert.ThatBytes(g, []byte("BAD:hello")).Decompressed("broken content")
+	})
+	restore()
+	runCase(t, func(g *mock.MockT) {
+		assert.ThatBytes(g, []byte("TAG:hello")).Decompressed().Equal([]byte("TAG:hello"))
+	})
+}
+
+func TestDecompressed_Reader(t *testing.T) {
+	runCase(t, func(g *mock.MockT) {
+		assert.ThatReader(g, bytes.NewReader(gzipped("hello, world!"))).Decompressed().ContentEqual("hello, world!")
+	})
+	runCase(t, func(g *mock.MockT) {
+		b := gzipped("hello, world!")
+		g.EXPECT().Error([]interface{}{fmt.Sprintf(`stream content not equal:
+ offset: 7
+    got: "hello, world!" <EOF>
+ expect: "hello, there!" <EOF>
+compressed: gzip, %d bytes read`, len(b))})
+		assert.ThatReader(g, bytes.NewReader(b)).Decompressed().ContentEqual("hello, there!")
+	})
+}
+
+func TestDecompressed_File(t *testing.T) {
+	t.Chdir(t.TempDir())
+	b := gzipped("port: 8080\n")
+	assert.Nil(t, os.WriteFile("app.yaml.gz", b, 0o644))
+
+	runCase(t, func(g *mock.MockT) {
+		assert.ThatFile(g, "app.yaml.gz").Decompressed().ContentEqual("port: 8080\n").DecodedEqual(yamlassert.Codec, "port: 8080")
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{fmt.Sprintf(`file content does not contain the specified substring:
+   path: "app.yaml.gz"
+    got: (string) "port: 8080\n"
+ expect: to contain substring "9090"
+compressed: gzip, %d bytes`, len(b))})
+		assert.ThatFile(g, "app.yaml.gz").Decompressed().ContentContains("9090")
+	})
+	runCase(t, func(g *mock.MockT) {
+		assert.Nil(t, os.WriteFile("broken.gz", []byte("\x1f\x8b"), 0o644))
+		g.EXPECT().Error([]interface{}{`unable to decompress file:
+   path: "broken.gz"
+ format: gzip
+  error: unexpected EOF
+message: config`})
+		assert.ThatFile(g, "broken.gz").Decompressed("config").ContentEqual("port: 8080\n", "content")
+	})
+}
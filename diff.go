@@ -0,0 +1,176 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"fmt"
+	"strings"
+)
+
+// fullDiffLines is the number of lines up to which line diffs are printed
+// whole in failure messages, see windowedDiff.
+const fullDiffLines = 50
+
+// diffContext returns the number of unchanged lines around hunks.
+func diffContext(s Settings) int {
+	if s.DiffContext == 0 {
+		return 3
+	}
+	return max(s.DiffContext, 0)
+}
+
+// maxDiffs returns the number of differences printed, or -1 for all.
+func maxDiffs(s Settings) int {
+	if s.MaxDiffs == 0 {
+		return 10
+	}
+	return max(s.MaxDiffs, -1)
+}
+
+// moreDiffs returns the summary line of n differences left out.
+func moreDiffs(n int) string {
+	if n == 1 {
+		return "…and 1 more difference"
+	}
+	return fmt.Sprintf("…and %d more differences", n)
+}
+
+// lineDiff returns a line-oriented diff between expect and got. Lines only
+// in expect are prefixed with "- ", lines only in got with "+ ", and common
+// lines with two spaces.
+func lineDiff(expect, got string) string {
+	return strings.Join(diffLines(expect, got), "\n")
+}
+
+// windowedDiff is like lineDiff for failure messages: when the diff is
+// longer than fullDiffLines, only the first hunks of changed lines are
+// printed, with some unchanged lines around them, and "  ..." stands for
+// the unchanged lines left out. See Settings.DiffContext and
+// Settings.MaxDiffs.
+func windowedDiff(expect, got string) string {
+	lines := diffLines(expect, got)
+	if len(lines) <= fullDiffLines {
+		return strings.Join(lines, "\n")
+	}
+	s := currentSettings()
+	context, limit := diffContext(s), maxDiffs(s)
+
+	// hunks holds the [start, end) ranges of lines printed, each made of
+	// changed lines and the unchanged lines around them.
+	var hunks [][2]int
+	for i, line := range lines {
+		if strings.HasPrefix(line, "  ") {
+			continue
+		}
+		start, end := max(i-context, 0), min(i+context+1, len(lines))
+		if n := len(hunks); n > 0 && start <= hunks[n-1][1] {
+			hunks[n-1][1] = end
+		} else {
+			hunks = append(hunks, [2]int{start, end})
+		}
+	}
+
+	var sb strings.Builder
+	next := 0
+	for i, h := range hunks {
+		if i == limit {
+			sb.WriteString("  ...\n" + moreDiffs(len(hunks)-limit) + "\n")
+			next = len(lines)
+			break
+		}
+		if h[0] > next {
+			sb.WriteString("  ...\n")
+		}
+		for _, line := range lines[h[0]:h[1]] {
+			sb.WriteString(line + "\n")
+		}
+		next = h[1]
+	}
+	if next < len(lines) {
+		sb.WriteString("  ...\n")
+	}
+	return strings.TrimSuffix(sb.String(), "\n")
+}
+
+// unifiedDiff returns a "diff:" section for failure messages, with the
+// windowedDiff of the lines of expect and got.
+func unifiedDiff(expect, got string) string {
+	return "   diff: (-expect +got)\n" + windowedDiff(expect, got)
+}
+
+// maxDiffLines bounds the number of lines of each side for which a diff is
+// computed, as diffLines needs memory quadratic in it.
+const maxDiffLines = 5000
+
+// diffable reports whether each of ss has few enough lines to be diffed.
+func diffable(ss ...string) bool {
+	for _, s := range ss {
+		if strings.Count(s, "\n") >= maxDiffLines {
+			return false
+		}
+	}
+	return true
+}
+
+// isMultiline reports whether any of ss spans several lines.
+func isMultiline(ss ...string) bool {
+	for _, s := range ss {
+		if strings.Contains(s, "\n") {
+			return true
+		}
+	}
+	return false
+}
+
+// diffLines returns the lines of the diff between expect and got, see
+// lineDiff.
+func diffLines(expect, got string) []string {
+	a := strings.Split(expect, "\n")
+	b := strings.Split(got, "\n")
+
+	// lcs[i*w+j] holds the length of the longest common subsequence of a[i:]
+	// and b[j:], in a single allocation.
+	w := len(b) + 1
+	lcs := make([]int, (len(a)+1)*w)
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i*w+j] = lcs[(i+1)*w+j+1] + 1
+			} else {
+				lcs[i*w+j] = max(lcs[(i+1)*w+j], lcs[i*w+j+1])
+			}
+		}
+	}
+
+	lines := make([]string, 0, max(len(a), len(b)))
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			lines = append(lines, "  "+a[i])
+			i++
+			j++
+		case i < len(a) && (j == len(b) || lcs[(i+1)*w+j] >= lcs[i*w+j+1]):
+			lines = append(lines, "- "+a[i])
+			i++
+		default:
+			lines = append(lines, "+ "+b[j])
+			j++
+		}
+	}
+	return lines
+}
@@ -0,0 +1,133 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/lvan100/go-assert/internal"
+)
+
+// EnvEqual reports a test failure if the environment variable key is not
+// set to expect.
+func EnvEqual(t internal.T, key string, expect string, msg ...interface{}) bool {
+	t.Helper()
+	defer track(t, nil)()
+	got, ok := os.LookupEnv(key)
+	if !ok {
+		str := fmt.Sprintf(`environment variable not set:
+    key: %q
+ expect: %q`, key, expect)
+		fail(t, str, msg...)
+		return false
+	}
+	if got != expect {
+		str := fmt.Sprintf(`environment variable mismatch:
+    key: %q
+    got: %q
+ expect: %q`, key, got, expect)
+		fail(t, str, msg...)
+		return false
+	}
+	return true
+}
+
+// EnvUnset reports a test failure if the environment variable key is set,
+// even to the empty string.
+func EnvUnset(t internal.T, key string, msg ...interface{}) bool {
+	t.Helper()
+	defer track(t, nil)()
+	if got, ok := os.LookupEnv(key); ok {
+		str := fmt.Sprintf(`environment variable is set:
+    key: %q
+    got: %q
+ expect: unset`, key, got)
+		fail(t, str, msg...)
+		return false
+	}
+	return true
+}
+
+// WorkingDirIs reports a test failure if the current working directory is
+// not dir. Both paths are made absolute and have symbolic links resolved
+// before comparison.
+func WorkingDirIs(t internal.T, dir string, msg ...interface{}) bool {
+	t.Helper()
+	defer track(t, nil)()
+	got, err := os.Getwd()
+	if err != nil {
+		fail(t, fmt.Sprintf("unable to get working directory: %v", err), msg...)
+		return false
+	}
+	if resolvePath(got) != resolvePath(dir) {
+		str := fmt.Sprintf(`working directory mismatch:
+    got: %q
+ expect: %q`, got, dir)
+		fail(t, str, msg...)
+		return false
+	}
+	return true
+}
+
+// resolvePath returns the absolute path of p with symbolic links resolved,
+// or p cleaned if that fails.
+func resolvePath(p string) string {
+	if abs, err := filepath.Abs(p); err == nil {
+		p = abs
+	}
+	if r, err := filepath.EvalSymlinks(p); err == nil {
+		p = r
+	}
+	return filepath.Clean(p)
+}
+
+// setenvT is implemented by test handlers, such as *testing.T, that set
+// environment variables for the duration of a test.
+type setenvT interface {
+	Setenv(key, value string)
+}
+
+// SetEnv sets the environment variable key to value for the rest of the
+// test through the test handler's Setenv method, as *testing.T provides, so
+// that it is restored when the test finishes and the test cannot run in
+// parallel. Otherwise the test fails.
+func SetEnv(t internal.T, key, value string) {
+	t.Helper()
+	if s, ok := findT[setenvT](t); ok {
+		s.Setenv(key, value)
+		return
+	}
+	fail(t, fmt.Sprintf("test handler %T does not provide a Setenv method", t))
+}
+
+// UnsetEnv is like SetEnv but unsets the environment variable key, which
+// t.Setenv cannot do.
+func UnsetEnv(t internal.T, key string) {
+	t.Helper()
+	s, ok := findT[setenvT](t)
+	if !ok {
+		fail(t, fmt.Sprintf("test handler %T does not provide a Setenv method", t))
+		return
+	}
+	// Setenv registers the restoration of the previous value
+	s.Setenv(key, "")
+	if err := os.Unsetenv(key); err != nil {
+		fail(t, fmt.Sprintf("unable to unset environment variable %q: %v", key, err))
+	}
+}
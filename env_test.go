@@ -0,0 +1,65 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/internal/mock"
+)
+
+func TestEnv(t *testing.T) {
+	assert.SetEnv(t, "GO_ASSERT_MODE", "test")
+	assert.UnsetEnv(t, "GO_ASSERT_UNSET")
+
+	runCase(t, func(g *mock.MockT) {
+		assert.EnvEqual(g, "GO_ASSERT_MODE", "test")
+		assert.EnvUnset(g, "GO_ASSERT_UNSET")
+	})
+
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"environment variable mismatch:\n    key: \"GO_ASSERT_MODE\"\n    got: \"test\"\n expect: \"prod\"\nmessage: config"})
+		g.EXPECT().Error([]interface{}{"environment variable not set:\n    key: \"GO_ASSERT_UNSET\"\n expect: \"x\""})
+		g.EXPECT().Error([]interface{}{"environment variable is set:\n    key: \"GO_ASSERT_MODE\"\n    got: \"test\"\n expect: unset"})
+		assert.EnvEqual(g, "GO_ASSERT_MODE", "prod", "config")
+		assert.EnvEqual(g, "GO_ASSERT_UNSET", "x")
+		assert.EnvUnset(g, "GO_ASSERT_MODE")
+	})
+
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"test handler *mock.MockT does not provide a Setenv method"})
+		assert.SetEnv(g, "GO_ASSERT_MODE", "x")
+	})
+}
+
+func TestWorkingDirIs(t *testing.T) {
+	dir := t.TempDir()
+	t.Chdir(dir)
+
+	runCase(t, func(g *mock.MockT) {
+		assert.WorkingDirIs(g, dir)
+		assert.WorkingDirIs(g, ".")
+	})
+
+	runCase(t, func(g *mock.MockT) {
+		wd, _ := os.Getwd()
+		g.EXPECT().Error([]interface{}{"working directory mismatch:\n    got: \"" + wd + "\"\n expect: \"/\""})
+		assert.WorkingDirIs(g, "/")
+	})
+}
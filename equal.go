@@ -0,0 +1,423 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// deepEqual is reflect.DeepEqual with a fast path for values of the
+// predeclared scalar types, which are compared without reflection.
+func deepEqual(x, y interface{}) bool {
+	switch x := x.(type) {
+	case bool:
+		return scalarEqual(x, y)
+	case string:
+		return scalarEqual(x, y)
+	case int:
+		return scalarEqual(x, y)
+	case int8:
+		return scalarEqual(x, y)
+	case int16:
+		return scalarEqual(x, y)
+	case int32:
+		return scalarEqual(x, y)
+	case int64:
+		return scalarEqual(x, y)
+	case uint:
+		return scalarEqual(x, y)
+	case uint8:
+		return scalarEqual(x, y)
+	case uint16:
+		return scalarEqual(x, y)
+	case uint32:
+		return scalarEqual(x, y)
+	case uint64:
+		return scalarEqual(x, y)
+	case uintptr:
+		return scalarEqual(x, y)
+	case float32:
+		return scalarEqual(x, y)
+	case float64:
+		return scalarEqual(x, y)
+	case complex64:
+		return scalarEqual(x, y)
+	case complex128:
+		return scalarEqual(x, y)
+	}
+	return reflect.DeepEqual(x, y)
+}
+
+// scalarEqual reports whether y is of the type of x and equal to it.
+func scalarEqual[T comparable](x T, y interface{}) bool {
+	v, ok := y.(T)
+	return ok && x == v
+}
+
+// valueDiff describes a difference found by deepCompare.
+type valueDiff struct {
+	path   string // display path, e.g. "Items[2].Name"
+	got    reflect.Value
+	expect reflect.Value
+	reason string // set when the difference is not between two leaf values
+}
+
+// deepCompare is a configurable variant of reflect.DeepEqual that reports
+// where two values differ.
+type deepCompare struct {
+	ignore   map[string]bool // dotted field paths to skip, e.g. "Items.ID"
+	exported bool            // skip unexported struct fields
+	visited  map[visit]bool
+
+	// all collects every difference into diffs instead of stopping at the first.
+	all   bool
+	diffs []*valueDiff
+
+	// aliasing also requires pointers and maps to alias in the same way on
+	// both sides, tracked by the following fields.
+	aliasing bool
+	gotSeen  map[ref]alias
+	expSeen  map[ref]alias
+}
+
+// ref identifies the object a pointer or map refers to. The type is part of
+// the key because a struct and its first field share the same address.
+type ref struct {
+	p   uintptr
+	typ reflect.Type
+}
+
+// alias records the counterpart of a pointer and where it was first seen.
+type alias struct {
+	other ref
+	path  string
+}
+
+// visit identifies a pair of pointers that is being compared, see reflect.DeepEqual.
+type visit struct {
+	x, y uintptr
+	typ  reflect.Type
+}
+
+// newDeepCompare returns a deepCompare that skips the given field paths.
+func newDeepCompare(ignore ...string) *deepCompare {
+	c := &deepCompare{
+		ignore:  make(map[string]bool, len(ignore)),
+		visited: make(map[visit]bool),
+	}
+	for _, p := range ignore {
+		c.ignore[p] = true
+	}
+	return c
+}
+
+// withAliasing enables the aliasing check and returns c.
+func (c *deepCompare) withAliasing() *deepCompare {
+	c.aliasing = true
+	c.gotSeen = make(map[ref]alias)
+	c.expSeen = make(map[ref]alias)
+	return c
+}
+
+// diff records d and returns nil when collecting all differences, and
+// returns d otherwise.
+func (c *deepCompare) diff(d *valueDiff) *valueDiff {
+	if c.all {
+		c.diffs = append(c.diffs, d)
+		return nil
+	}
+	return d
+}
+
+// compareAll returns every difference between got and expect.
+func (c *deepCompare) compareAll(got, expect interface{}) []*valueDiff {
+	c.all = true
+	c.values(reflect.ValueOf(got), reflect.ValueOf(expect), "", "")
+	return c.diffs
+}
+
+// compare returns the first difference between got and expect, or nil.
+func (c *deepCompare) compare(got, expect interface{}) *valueDiff {
+	return c.values(reflect.ValueOf(got), reflect.ValueOf(expect), "", "")
+}
+
+// values compares x and y. path is the display path of the values, and
+// fields their path made of field names only, used to match ignored paths.
+func (c *deepCompare) values(x, y reflect.Value, path, fields string) *valueDiff {
+	if !x.IsValid() || !y.IsValid() {
+		if x.IsValid() == y.IsValid() {
+			return nil
+		}
+		return c.diff(&valueDiff{path: path, got: x, expect: y})
+	}
+	if x.Type() != y.Type() {
+		return c.diff(&valueDiff{path: path, got: x, expect: y})
+	}
+
+	if c.aliasing && (x.Kind() == reflect.Ptr || x.Kind() == reflect.Map) && !x.IsNil() && !y.IsNil() {
+		if d := c.checkAlias(x, y, path); d != nil {
+			return d
+		}
+	}
+
+	switch x.Kind() {
+	case reflect.Map, reflect.Slice, reflect.Ptr, reflect.Interface:
+		if x.Kind() != reflect.Interface && !x.IsNil() && !y.IsNil() {
+			v := visit{x.Pointer(), y.Pointer(), x.Type()}
+			if c.visited[v] {
+				return nil
+			}
+			c.visited[v] = true
+		}
+	}
+
+	switch x.Kind() {
+	case reflect.Array:
+		for i := 0; i < x.Len(); i++ {
+			if d := c.values(x.Index(i), y.Index(i), fmt.Sprintf("%s[%d]", path, i), fields); d != nil {
+				return d
+			}
+		}
+		return nil
+	case reflect.Slice:
+		if x.IsNil() != y.IsNil() {
+			return c.diff(&valueDiff{path: path, got: x, expect: y})
+		}
+		if x.Len() != y.Len() {
+			return c.diff(&valueDiff{path: path, got: x, expect: y,
+				reason: fmt.Sprintf("length %d but expect length %d", x.Len(), y.Len())})
+		}
+		for i := 0; i < x.Len(); i++ {
+			if d := c.values(x.Index(i), y.Index(i), fmt.Sprintf("%s[%d]", path, i), fields); d != nil {
+				return d
+			}
+		}
+		return nil
+	case reflect.Interface, reflect.Ptr:
+		if x.IsNil() || y.IsNil() {
+			if x.IsNil() == y.IsNil() {
+				return nil
+			}
+			return c.diff(&valueDiff{path: path, got: x, expect: y})
+		}
+		return c.values(x.Elem(), y.Elem(), path, fields)
+	case reflect.Struct:
+		for i := 0; i < x.NumField(); i++ {
+			sf := x.Type().Field(i)
+			if c.exported && !sf.IsExported() {
+				continue
+			}
+			name := sf.Name
+			f := name
+			if fields != "" {
+				f = fields + "." + name
+			}
+			if c.ignore[f] {
+				continue
+			}
+			p := name
+			if path != "" {
+				p = path + "." + name
+			}
+			if d := c.values(x.Field(i), y.Field(i), p, f); d != nil {
+				return d
+			}
+		}
+		return nil
+	case reflect.Map:
+		if x.IsNil() != y.IsNil() {
+			return c.diff(&valueDiff{path: path, got: x, expect: y})
+		}
+		keys := x.MapKeys()
+		sort.Slice(keys, func(i, j int) bool {
+			return fmt.Sprint(keys[i]) < fmt.Sprint(keys[j])
+		})
+		for _, k := range keys {
+			p := fmt.Sprintf("%s[%v]", path, k)
+			yv := y.MapIndex(k)
+			if !yv.IsValid() {
+				if d := c.diff(&valueDiff{path: p, got: x.MapIndex(k), reason: "unexpected key"}); d != nil {
+					return d
+				}
+				continue
+			}
+			if d := c.values(x.MapIndex(k), yv, p, fields); d != nil {
+				return d
+			}
+		}
+		if x.Len() != y.Len() {
+			for _, k := range y.MapKeys() {
+				if !x.MapIndex(k).IsValid() {
+					if d := c.diff(&valueDiff{path: fmt.Sprintf("%s[%v]", path, k), expect: y.MapIndex(k), reason: "missing key"}); d != nil {
+						return d
+					}
+				}
+			}
+		}
+		return nil
+	case reflect.Func:
+		if x.IsNil() && y.IsNil() {
+			return nil
+		}
+		return c.diff(&valueDiff{path: path, got: x, expect: y, reason: "func values are only equal if both are nil"})
+	default:
+		if !leafEqual(x, y) {
+			return c.diff(&valueDiff{path: path, got: x, expect: y})
+		}
+		return nil
+	}
+}
+
+// checkAlias verifies that the pointers x and y are consistently paired:
+// if x was seen before, it must have been paired with y and vice versa.
+func (c *deepCompare) checkAlias(x, y reflect.Value, path string) *valueDiff {
+	px, py := ref{x.Pointer(), x.Type()}, ref{y.Pointer(), y.Type()}
+	if a, ok := c.gotSeen[px]; ok && a.other != py {
+		return c.diff(&valueDiff{path: path, got: x, expect: y,
+			reason: fmt.Sprintf("got aliases %s, expect does not", displayPath(a.path))})
+	}
+	if a, ok := c.expSeen[py]; ok && a.other != px {
+		return c.diff(&valueDiff{path: path, got: x, expect: y,
+			reason: fmt.Sprintf("expect aliases %s, got does not", displayPath(a.path))})
+	}
+	if _, ok := c.gotSeen[px]; !ok {
+		c.gotSeen[px] = alias{other: py, path: path}
+		c.expSeen[py] = alias{other: px, path: path}
+	}
+	return nil
+}
+
+// displayPath returns path, or "(root)" for the root value.
+func displayPath(path string) string {
+	if path == "" {
+		return "(root)"
+	}
+	return path
+}
+
+// leafEqual compares two values of the same basic kind, including values
+// read from unexported fields.
+func leafEqual(x, y reflect.Value) bool {
+	switch x.Kind() {
+	case reflect.Bool:
+		return x.Bool() == y.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return x.Int() == y.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return x.Uint() == y.Uint()
+	case reflect.Float32, reflect.Float64:
+		return x.Float() == y.Float()
+	case reflect.Complex64, reflect.Complex128:
+		return x.Complex() == y.Complex()
+	case reflect.String:
+		return x.String() == y.String()
+	case reflect.Chan, reflect.UnsafePointer:
+		return x.Pointer() == y.Pointer()
+	default:
+		return false
+	}
+}
+
+// String renders the difference for failure messages.
+func (d *valueDiff) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "   path: %s", displayPath(d.path))
+	if d.reason != "" {
+		fmt.Fprintf(&sb, "\n   note: %s", d.reason)
+	}
+	if d.got.IsValid() {
+		fmt.Fprintf(&sb, "\n    got: (%s) %s", d.got.Type(), formatValue(d.got))
+	} else if d.reason == "" {
+		sb.WriteString("\n    got: <nil>")
+	}
+	if d.expect.IsValid() {
+		fmt.Fprintf(&sb, "\n expect: (%s) %s", d.expect.Type(), formatValue(d.expect))
+	} else if d.reason == "" {
+		sb.WriteString("\n expect: <nil>")
+	}
+	return sb.String()
+}
+
+// diffTable renders diffs as a table with path, got and expect columns,
+// up to Settings.MaxDiffs of them.
+func diffTable(diffs []*valueDiff) string {
+	rows := [][]string{{"path", "got", "expect"}}
+	limit := maxDiffs(currentSettings())
+	for i, d := range diffs {
+		if i == limit {
+			break
+		}
+		rows = append(rows, []string{displayPath(d.path), d.gotString(), d.expectString()})
+	}
+	str := renderTable(rows)
+	if limit >= 0 && len(diffs) > limit {
+		str += "\n    " + moreDiffs(len(diffs)-limit)
+	}
+	return str
+}
+
+// gotString renders the got side of d for tables.
+func (d *valueDiff) gotString() string {
+	return diffSide(d.got, d.reason)
+}
+
+// expectString renders the expect side of d for tables.
+func (d *valueDiff) expectString() string {
+	return diffSide(d.expect, d.reason)
+}
+
+func diffSide(v reflect.Value, reason string) string {
+	if v.IsValid() {
+		return formatValue(v)
+	}
+	if reason != "" {
+		return "<missing>"
+	}
+	return "<nil>"
+}
+
+// renderTable renders rows as indented columns separated by " | ". The
+// last column is not padded.
+func renderTable(rows [][]string) string {
+	var width []int
+	for _, row := range rows {
+		for i, cell := range row[:len(row)-1] {
+			if i == len(width) {
+				width = append(width, 0)
+			}
+			width[i] = max(width[i], len(cell))
+		}
+	}
+	var sb strings.Builder
+	for i, row := range rows {
+		if i > 0 {
+			sb.WriteByte('\n')
+		}
+		sb.WriteString("    ")
+		for j, cell := range row {
+			if j == len(row)-1 {
+				sb.WriteString(cell)
+			} else {
+				fmt.Fprintf(&sb, "%-*s | ", width[j], cell)
+			}
+		}
+	}
+	return sb.String()
+}
@@ -40,65 +40,84 @@ func ThatError(t internal.T, v error) *ErrorAssertion {
 }
 
 // IsNil reports a test failure if the error is not nil.
-func (a *ErrorAssertion) IsNil(msg ...string) {
+func (a *ErrorAssertion) IsNil(msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	if a.v != nil {
 		fail(a.t, "expect nil error, got: "+a.v.Error(), msg...)
+		return false
 	}
+	return true
 }
 
 // IsNotNil reports a test failure if the error is nil.
-func (a *ErrorAssertion) IsNotNil(msg ...string) {
+func (a *ErrorAssertion) IsNotNil(msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	if a.v == nil {
 		fail(a.t, "expect not nil error", msg...)
+		return false
 	}
+	return true
 }
 
 // Is reports a test failure if the error is not the same as the given error.
-func (a *ErrorAssertion) Is(target error, msg ...string) {
+func (a *ErrorAssertion) Is(target error, msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	if !errors.Is(target, a.v) {
 		fail(a.t, "expect error: "+target.Error()+", got: "+a.v.Error(), msg...)
+		return false
 	}
+	return true
 }
 
 // IsNot reports a test failure if the error is the same as the given error.
-func (a *ErrorAssertion) IsNot(target error, msg ...string) {
+func (a *ErrorAssertion) IsNot(target error, msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	if errors.Is(target, a.v) {
 		fail(a.t, "expect error not to be: "+target.Error(), msg...)
+		return false
 	}
+	return true
 }
 
 // As checks if the error can be converted to the target type.
-func (a *ErrorAssertion) As(target interface{}, msg ...string) {
+func (a *ErrorAssertion) As(target interface{}, msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	if !errors.As(a.v, &target) {
 		fail(a.t, "expect error to be of type: "+fmt.Sprintf("%T", target), msg...)
+		return false
 	}
+	return true
 }
 
 // ContainsMessage reports a test failure if the error message does not contain the given substring.
-func (a *ErrorAssertion) ContainsMessage(substring string, msg ...string) {
+func (a *ErrorAssertion) ContainsMessage(substring string, msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	if a.v == nil {
 		fail(a.t, "expect not nil error", msg...)
-		return
+		return false
 	}
 	if !strings.Contains(a.v.Error(), substring) {
 		fail(a.t, "expect error message to contain: "+substring+", got: "+a.v.Error(), msg...)
+		return false
 	}
+	return true
 }
 
 // Matches reports a test failure if the error string does not match the given expression.
 // It expects a non-nil error and uses the provided expression (typically a regex)
 // to validate the error message content. Optional custom failure messages can be provided.
-func (a *ErrorAssertion) Matches(expr string, msg ...string) {
+func (a *ErrorAssertion) Matches(expr string, msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	if a.v == nil {
 		fail(a.t, "expect not nil error", msg...)
-		return
+		return false
 	}
-	matches(a.t, a.v.Error(), expr, msg...)
+	return matches(a.t, a.v.Error(), expr, msg...)
 }
@@ -21,30 +21,30 @@ import (
 	"testing"
 
 	"github.com/lvan100/go-assert"
-	"github.com/lvan100/go-assert/internal"
+	"github.com/lvan100/go-assert/internal/mock"
 )
 
 func TestError_Matches(t *testing.T) {
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		assert.ThatError(g, errors.New("this is an error")).Matches("an error")
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{"invalid pattern"})
 		assert.ThatError(g, errors.New("there's no error")).Matches("an error \\")
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{"expect not nil error"})
 		assert.ThatError(g, nil).Matches("an error")
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{"expect not nil error, param (index=0)"})
 		assert.ThatError(g, nil).Matches("an error", "param (index=0)")
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{"got \"there's no error\" which does not match \"an error\""})
 		assert.ThatError(g, errors.New("there's no error")).Matches("an error")
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{"got \"there's no error\" which does not match \"an error\", param (index=0)"})
 		assert.ThatError(g, errors.New("there's no error")).Matches("an error", "param (index=0)")
 	})
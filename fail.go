@@ -0,0 +1,50 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"fmt"
+
+	"github.com/lvan100/go-assert/internal"
+)
+
+// Fail reports a test failure with a message formatted from format and
+// args, through the same pipeline as failing assertions: context prefixes,
+// templates, reporters, counting and fail-fast all apply.
+func Fail(t internal.T, format string, args ...interface{}) {
+	t.Helper()
+	defer track(t, nil)()
+	fail(t, fmt.Sprintf(format, args...))
+}
+
+// FailNow is like Fail but always stops the test, as t.FailNow does,
+// whatever the fail-fast setting.
+func FailNow(t internal.T, format string, args ...interface{}) {
+	t.Helper()
+	defer track(t, nil)()
+	fail(New(t).WithFailFast(true), fmt.Sprintf(format, args...))
+}
+
+// SkipUnless skips the test with a message formatted from format and args
+// unless cond is true.
+func SkipUnless(t internal.T, cond bool, format string, args ...interface{}) {
+	t.Helper()
+	if cond {
+		return
+	}
+	t.Skip(fmt.Sprintf(format, args...))
+}
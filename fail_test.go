@@ -0,0 +1,84 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/internal/mock"
+)
+
+func TestFail(t *testing.T) {
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"unexpected state: boom"})
+		assert.Fail(g, "unexpected state: %v", errors.New("boom"))
+	})
+
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"loading fixtures: no fixtures"})
+		assert.Fail(assert.New(g).WithContext("loading fixtures"), "no fixtures")
+	})
+
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Fatal([]interface{}{"cannot continue after 3 errors"})
+		assert.FailNow(g, "cannot continue after %d errors", 3)
+	})
+}
+
+func TestSkipUnless(t *testing.T) {
+	t.Run("skipped", func(t *testing.T) {
+		assert.SkipUnless(t, false, "requires %s", "docker")
+		t.Fatal("not skipped")
+	})
+
+	runCase(t, func(g *mock.MockT) {
+		assert.SkipUnless(g, true, "requires docker")
+	})
+
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Skip([]interface{}{"requires docker"})
+		assert.SkipUnless(assert.New(g), false, "requires docker")
+	})
+}
+
+// any testing.TB is a test handler
+var (
+	_ assert.T = testing.TB(nil)
+	_ assert.T = (*testing.T)(nil)
+	_ assert.T = (*testing.B)(nil)
+	_ assert.T = (*testing.F)(nil)
+)
+
+// checkUser is a test helper taking a testing.TB, as shared helpers do.
+func checkUser(tb testing.TB, name string) {
+	tb.Helper()
+	assert.SkipUnless(assert.Must(tb), name != "", "no user")
+	assert.ThatString(assert.Must(tb), name).HasPrefix("u-")
+}
+
+func TestTestingTB(t *testing.T) {
+	checkUser(t, "u-1")
+	testing.Benchmark(func(b *testing.B) {
+		checkUser(b, "u-1")
+	})
+	t.Run("skipped", func(t *testing.T) {
+		checkUser(t, "")
+		t.Fatal("not skipped")
+	})
+}
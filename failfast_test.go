@@ -0,0 +1,58 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/internal/mock"
+)
+
+func TestSetFailFast(t *testing.T) {
+	t.Run("global", func(t *testing.T) {
+		defer assert.SetFailFast(true)()
+		runCase(t, func(g *mock.MockT) {
+			g.EXPECT().Fatal([]interface{}{"got false but expect true"})
+			assert.True(g, false)
+		})
+		runCase(t, func(g *mock.MockT) {
+			g.EXPECT().Error([]interface{}{"got false but expect true"})
+			assert.True(assert.New(g).WithFailFast(false), false)
+		})
+	})
+	t.Run("asserter", func(t *testing.T) {
+		runCase(t, func(g *mock.MockT) {
+			g.EXPECT().Fatal([]interface{}{`strings not equal:
+    got: (string) "a"
+ expect: (string) "b"`})
+			assert.ThatString(assert.New(g).WithFailFast(true), "a").Equal("b")
+		})
+	})
+}
+
+func TestMust(t *testing.T) {
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Fatal([]interface{}{"expect nil error, got: boom"})
+		assert.ThatError(assert.Must(g), errors.New("boom")).IsNil()
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Fatal([]interface{}{"loading config: got false but expect true"})
+		assert.True(assert.Must(assert.New(g).WithContext("loading config")), false)
+	})
+}
@@ -0,0 +1,411 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"strings"
+
+	"github.com/lvan100/go-assert/internal"
+)
+
+// FileAssertion encapsulates a path on the local file system and a test
+// handler for making assertions on it. By default symbolic links are
+// followed; use NoFollow to inspect links themselves.
+type FileAssertion struct {
+	t        internal.T
+	path     string
+	name     string // path shown in failure messages
+	noFollow bool
+	decode   bool // decompress content, see Decompressed
+	textual  bool // normalize line endings, see IgnoringLineEndings
+
+	decodeMsg []interface{} // message of decoding failures, see Decompressed
+}
+
+// ThatFile returns a FileAssertion for the given testing object and path.
+func ThatFile(t internal.T, path string) *FileAssertion {
+	return &FileAssertion{
+		t:    chain(t),
+		path: path,
+		name: path,
+	}
+}
+
+// NoFollow returns a FileAssertion that does not follow a symbolic link at
+// the path: type checks see the link itself and content assertions fail
+// instead of reading the link target.
+func (a *FileAssertion) NoFollow() *FileAssertion {
+	return &FileAssertion{
+		t:         a.t,
+		path:      a.path,
+		name:      a.name,
+		noFollow:  true,
+		decode:    a.decode,
+		textual:   a.textual,
+		decodeMsg: a.decodeMsg,
+	}
+}
+
+// Decompressed returns a FileAssertion whose content assertions compare the
+// decoded content if the file is compressed, detected from its magic number:
+// gzip, or a format registered with RegisterDecompressor. Failure messages
+// then also report the format and compressed size. Uncompressed files are
+// compared as they are. If the content cannot be decoded, the content
+// assertions report a test failure with msg, or with their own message if
+// msg is empty.
+func (a *FileAssertion) Decompressed(msg ...interface{}) *FileAssertion {
+	return &FileAssertion{
+		t:         a.t,
+		path:      a.path,
+		name:      a.name,
+		noFollow:  a.noFollow,
+		decode:    true,
+		textual:   a.textual,
+		decodeMsg: msg,
+	}
+}
+
+// IgnoringLineEndings returns a FileAssertion whose content assertions
+// normalize CRLF and CR line endings to LF and strip a leading UTF-8 byte
+// order mark, on both the file's content and the expected string.
+func (a *FileAssertion) IgnoringLineEndings() *FileAssertion {
+	return &FileAssertion{
+		t:         a.t,
+		path:      a.path,
+		name:      a.name,
+		noFollow:  a.noFollow,
+		decode:    a.decode,
+		textual:   true,
+		decodeMsg: a.decodeMsg,
+	}
+}
+
+// norm normalizes s if the assertion ignores line endings.
+func (a *FileAssertion) norm(s string) string {
+	if a.textual {
+		return normalizeText(s)
+	}
+	return s
+}
+
+func (a *FileAssertion) stat() (fs.FileInfo, error) {
+	if a.noFollow {
+		info, err := os.Lstat(a.path)
+		return info, a.pathError(err)
+	}
+	info, err := os.Stat(a.path)
+	return info, a.pathError(err)
+}
+
+// pathError rewrites the path of an *fs.PathError to the display name, so
+// that failure messages don't leak absolute paths of temporary directories.
+func (a *FileAssertion) pathError(err error) error {
+	var e *fs.PathError
+	if a.name != a.path && errors.As(err, &e) {
+		return &fs.PathError{Op: e.Op, Path: a.name, Err: e.Err}
+	}
+	return err
+}
+
+// Exists reports a test failure if the path does not exist.
+func (a *FileAssertion) Exists(msg ...interface{}) *FileAssertion {
+	a.t.Helper()
+	defer track(a.t, nil)()
+	if _, err := a.stat(); err != nil {
+		str := fmt.Sprintf(`path does not exist:
+   path: %q
+ expect: to exist
+  error: %v`, a.name, err)
+		fail(a.t, str, msg...)
+	}
+	return a
+}
+
+// NotExists reports a test failure if the path exists.
+func (a *FileAssertion) NotExists(msg ...interface{}) *FileAssertion {
+	a.t.Helper()
+	defer track(a.t, nil)()
+	if _, err := a.stat(); err == nil {
+		str := fmt.Sprintf(`path exists:
+   path: %q
+ expect: not to exist`, a.name)
+		fail(a.t, str, msg...)
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		str := fmt.Sprintf(`unable to stat path:
+   path: %q
+ expect: not to exist
+  error: %v`, a.name, err)
+		fail(a.t, str, msg...)
+	}
+	return a
+}
+
+// IsFile reports a test failure if the path does not exist or is not a regular file.
+func (a *FileAssertion) IsFile(msg ...interface{}) *FileAssertion {
+	a.t.Helper()
+	defer track(a.t, nil)()
+	info, err := a.stat()
+	if err != nil {
+		str := fmt.Sprintf(`path does not exist:
+   path: %q
+ expect: regular file
+  error: %v`, a.name, err)
+		fail(a.t, str, msg...)
+		return a
+	}
+	if !info.Mode().IsRegular() {
+		str := fmt.Sprintf(`path is not a regular file:
+   path: %q
+    got: mode %v
+ expect: regular file`, a.name, info.Mode())
+		fail(a.t, str, msg...)
+	}
+	return a
+}
+
+// IsDir reports a test failure if the path does not exist or is not a directory.
+func (a *FileAssertion) IsDir(msg ...interface{}) *FileAssertion {
+	a.t.Helper()
+	defer track(a.t, nil)()
+	info, err := a.stat()
+	if err != nil {
+		str := fmt.Sprintf(`path does not exist:
+   path: %q
+ expect: directory
+  error: %v`, a.name, err)
+		fail(a.t, str, msg...)
+		return a
+	}
+	if !info.IsDir() {
+		str := fmt.Sprintf(`path is not a directory:
+   path: %q
+    got: mode %v
+ expect: directory`, a.name, info.Mode())
+		fail(a.t, str, msg...)
+	}
+	return a
+}
+
+// IsSymlink reports a test failure if the path is not a symbolic link.
+// The link itself is inspected regardless of NoFollow.
+func (a *FileAssertion) IsSymlink(msg ...interface{}) *FileAssertion {
+	a.t.Helper()
+	defer track(a.t, nil)()
+	info, err := os.Lstat(a.path)
+	if err != nil {
+		err = a.pathError(err)
+		str := fmt.Sprintf(`path does not exist:
+   path: %q
+ expect: symbolic link
+  error: %v`, a.name, err)
+		fail(a.t, str, msg...)
+		return a
+	}
+	if info.Mode()&fs.ModeSymlink == 0 {
+		str := fmt.Sprintf(`path is not a symbolic link:
+   path: %q
+    got: mode %v
+ expect: symbolic link`, a.name, info.Mode())
+		fail(a.t, str, msg...)
+	}
+	return a
+}
+
+// SymlinkTarget reports a test failure if the path is not a symbolic link
+// or its target, as stored in the link, is not equal to expect.
+func (a *FileAssertion) SymlinkTarget(expect string, msg ...interface{}) *FileAssertion {
+	a.t.Helper()
+	defer track(a.t, nil)()
+	target, err := os.Readlink(a.path)
+	if err != nil {
+		err = a.pathError(err)
+		str := fmt.Sprintf(`unable to read symbolic link:
+   path: %q
+ expect: link to %q
+  error: %v`, a.name, show(a.t, expect), err)
+		fail(a.t, str, msg...)
+		return a
+	}
+	if target != expect {
+		str := fmt.Sprintf(`symbolic link target mismatch:
+   path: %q
+    got: link to %q
+ expect: link to %q`, a.name, target, show(a.t, expect))
+		fail(a.t, str, msg...)
+	}
+	return a
+}
+
+// HasSize reports a test failure if the file's size is not equal to the expected size.
+func (a *FileAssertion) HasSize(size int64, msg ...interface{}) *FileAssertion {
+	a.t.Helper()
+	defer track(a.t, nil)()
+	info, err := a.stat()
+	if err != nil {
+		str := fmt.Sprintf(`path does not exist:
+   path: %q
+ expect: size %d
+  error: %v`, a.name, size, err)
+		fail(a.t, str, msg...)
+		return a
+	}
+	if info.Size() != size {
+		str := fmt.Sprintf(`file size mismatch:
+   path: %q
+    got: size %d
+ expect: size %d`, a.name, info.Size(), size)
+		fail(a.t, str, msg...)
+	}
+	return a
+}
+
+// ContentEqual reports a test failure if the file's content is not equal to the expected string.
+func (a *FileAssertion) ContentEqual(expect string, msg ...interface{}) *FileAssertion {
+	a.t.Helper()
+	defer track(a.t, nil)()
+	b, note, ok := a.readFile(msg...)
+	if !ok {
+		return a
+	}
+	got, expect := a.norm(string(b)), a.norm(expect)
+	if got != expect {
+		str := fmt.Sprintf(`file content not equal:
+   path: %q
+    got: (%T) %q
+ expect: (%T) %q`, a.name, got, show(a.t, got), expect, show(a.t, expect))
+		failValues(a.t, got, expect, str+lineEndingNote(got, expect)+note, msg...)
+	}
+	return a
+}
+
+// ContentContains reports a test failure if the file's content does not contain the substring.
+func (a *FileAssertion) ContentContains(substr string, msg ...interface{}) *FileAssertion {
+	a.t.Helper()
+	defer track(a.t, nil)()
+	b, note, ok := a.readFile(msg...)
+	if !ok {
+		return a
+	}
+	got, substr := a.norm(string(b)), a.norm(substr)
+	if !strings.Contains(got, substr) {
+		str := fmt.Sprintf(`file content does not contain the specified substring:
+   path: %q
+    got: (%T) %q
+ expect: to contain substring %q`, a.name, got, show(a.t, got), show(a.t, substr))
+		fail(a.t, str+note, msg...)
+	}
+	return a
+}
+
+// JSONEqual reports a test failure if the file's content and the expected
+// string are not equivalent JSON documents.
+func (a *FileAssertion) JSONEqual(expect string, msg ...interface{}) *FileAssertion {
+	a.t.Helper()
+	defer track(a.t, nil)()
+	a.structEqual("JSON", json.Unmarshal, expect, msg...)
+	return a
+}
+
+// DecodedEqual reports a test failure if the file's content and the
+// expected string, both decoded with the Unmarshal method of c, are not
+// deeply equal, e.g. to compare YAML documents with yamlassert.Codec.
+func (a *FileAssertion) DecodedEqual(c Codec, expect string, msg ...interface{}) *FileAssertion {
+	a.t.Helper()
+	defer track(a.t, nil)()
+	a.structEqual(strings.ToUpper(c.Name()), c.Unmarshal, expect, msg...)
+	return a
+}
+
+// structEqual decodes the file's content and expect with unmarshal and
+// reports a test failure if the decoded structures are not deeply equal.
+func (a *FileAssertion) structEqual(format string, unmarshal func([]byte, interface{}) error, expect string, msg ...interface{}) {
+	a.t.Helper()
+	b, note, ok := a.readFile(msg...)
+	if !ok {
+		return
+	}
+	got := string(b)
+	var gotV, expectV interface{}
+	if err := unmarshal(b, &gotV); err != nil {
+		str := fmt.Sprintf(`invalid %s in file:
+   path: %q
+    got: (%T) %q
+  error: %v`, format, a.name, got, show(a.t, got), err)
+		fail(a.t, str+note, msg...)
+		return
+	}
+	if err := unmarshal([]byte(expect), &expectV); err != nil {
+		str := fmt.Sprintf(`invalid %s in expect value:
+   path: %q
+ expect: (%T) %q
+  error: %v`, format, a.name, expect, show(a.t, expect), err)
+		fail(a.t, str+note, msg...)
+		return
+	}
+	if equal, ok := equalValues(a.t, gotV, expectV, msg...); ok && !equal {
+		str := fmt.Sprintf(`%s structures are not equal:
+   path: %q
+    got: (%T) %q
+ expect: (%T) %q`, format, a.name, got, show(a.t, got), expect, show(a.t, expect))
+		failValues(a.t, got, expect, str+note, msg...)
+	}
+}
+
+// readFile reads the file, reporting a test failure if it cannot be read or,
+// in NoFollow mode, if the path is a symbolic link. In Decompressed mode the
+// decoded content is returned together with the note for failure messages.
+func (a *FileAssertion) readFile(msg ...interface{}) ([]byte, string, bool) {
+	a.t.Helper()
+	if a.noFollow {
+		if info, err := os.Lstat(a.path); err == nil && info.Mode()&fs.ModeSymlink != 0 {
+			str := fmt.Sprintf(`path is a symbolic link and links are not followed:
+   path: %q`, a.name)
+			fail(a.t, str, msg...)
+			return nil, "", false
+		}
+	}
+	b, err := os.ReadFile(a.path)
+	if err != nil {
+		err = a.pathError(err)
+		str := fmt.Sprintf(`unable to read file:
+   path: %q
+  error: %v`, a.name, err)
+		fail(a.t, str, msg...)
+		return nil, "", false
+	}
+	if !a.decode {
+		return b, "", true
+	}
+	d, format, err := decompressBytes(b)
+	if err != nil {
+		str := fmt.Sprintf(`unable to decompress file:
+   path: %q
+ format: %s
+  error: %v`, a.name, format, err)
+		if len(a.decodeMsg) > 0 {
+			msg = a.decodeMsg
+		}
+		fail(a.t, str, msg...)
+		return nil, "", false
+	}
+	return d, compressionNote(format, int64(len(b))), true
+}
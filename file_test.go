@@ -0,0 +1,115 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/internal/mock"
+)
+
+func TestFile_Symlink(t *testing.T) {
+	t.Chdir(t.TempDir())
+	assert.Nil(t, os.WriteFile("app.yaml", []byte("port: 8080\n"), 0o644))
+	assert.Nil(t, os.Chmod("app.yaml", 0o644))
+	assert.Nil(t, os.Symlink("app.yaml", "current.yaml"))
+	assert.Nil(t, os.Mkdir("conf", 0o755))
+
+	runCase(t, func(g *mock.MockT) {
+		assert.ThatFile(g, "current.yaml").
+			Exists().
+			IsFile().
+			IsSymlink().
+			SymlinkTarget("app.yaml").
+			HasSize(11).
+			ContentEqual("port: 8080\n").
+			ContentContains("8080")
+		assert.ThatFile(g, "conf").IsDir()
+		assert.ThatFile(g, filepath.Join("conf", "x")).NotExists()
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`path is not a symbolic link:
+   path: "app.yaml"
+    got: mode -rw-r--r--
+ expect: symbolic link`})
+		assert.ThatFile(g, "app.yaml").IsSymlink()
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`symbolic link target mismatch:
+   path: "current.yaml"
+    got: link to "app.yaml"
+ expect: link to "old.yaml"`})
+		assert.ThatFile(g, "current.yaml").SymlinkTarget("old.yaml")
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`path is not a regular file:
+   path: "current.yaml"
+    got: mode Lrwxrwxrwx
+ expect: regular file`})
+		g.EXPECT().Error([]interface{}{`path is a symbolic link and links are not followed:
+   path: "current.yaml"`})
+		assert.ThatFile(assert.New(g).WithContinueChains(true), "current.yaml").NoFollow().IsFile().ContentEqual("port: 8080\n")
+	})
+}
+
+func TestFile_Content(t *testing.T) {
+	t.Chdir(t.TempDir())
+	assert.Nil(t, os.WriteFile("app.yaml", []byte("port: 8080\n"), 0o644))
+
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`file content not equal:
+   path: "app.yaml"
+    got: (string) "port: 8080\n"
+ expect: (string) "port: 9090\n"
+message: param (index=0)`})
+		assert.ThatFile(g, "app.yaml").ContentEqual("port: 9090\n", "param (index=0)")
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`path exists:
+   path: "app.yaml"
+ expect: not to exist`})
+		assert.ThatFile(g, "app.yaml").NotExists()
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`unable to read file:
+   path: "missing.yaml"
+  error: open missing.yaml: no such file or directory`})
+		assert.ThatFile(g, "missing.yaml").ContentContains("port")
+	})
+}
+
+func TestFile_IgnoringLineEndings(t *testing.T) {
+	t.Chdir(t.TempDir())
+	assert.Nil(t, os.WriteFile("app.yaml", []byte("\ufeffname: app\r\nport: 8080\r\n"), 0o644))
+
+	runCase(t, func(g *mock.MockT) {
+		assert.ThatFile(g, "app.yaml").IgnoringLineEndings().
+			ContentEqual("name: app\nport: 8080\n").
+			ContentContains("app\nport")
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`file content not equal:
+   path: "app.yaml"
+    got: (string) "\ufeffname: app\r\nport: 8080\r\n"
+ expect: (string) "name: app\nport: 8080\n"
+   note: strings differ only in line endings or byte order mark`})
+		assert.ThatFile(g, "app.yaml").ContentEqual("name: app\nport: 8080\n")
+	})
+}
@@ -0,0 +1,76 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+var formatters = struct {
+	sync.RWMutex
+	m map[reflect.Type]func(interface{}) string
+}{m: make(map[reflect.Type]func(interface{}) string)}
+
+// RegisterFormatter registers fn to render values of type T in failure
+// messages, e.g. to print money amounts in a readable form or to redact
+// secrets. It applies to the values reported by all assertions, to the
+// fields and elements listed in diffs, and to nested values in full dumps,
+// see VerbosityFull. A later registration for the same type replaces the
+// earlier one. The returned function restores the previous formatter:
+//
+//	defer assert.RegisterFormatter(func(p Password) string { return "***" })()
+func RegisterFormatter[T any](fn func(T) string) (restore func()) {
+	typ := reflect.TypeOf((*T)(nil)).Elem()
+	formatters.Lock()
+	defer formatters.Unlock()
+	prev, ok := formatters.m[typ]
+	formatters.m[typ] = func(v interface{}) string { return fn(v.(T)) }
+	return func() {
+		formatters.Lock()
+		defer formatters.Unlock()
+		if ok {
+			formatters.m[typ] = prev
+		} else {
+			delete(formatters.m, typ)
+		}
+	}
+}
+
+// formatted returns the output of the formatter registered for the type of
+// v, or false if there is none or v cannot be accessed.
+func formatted(v reflect.Value) (string, bool) {
+	if !v.IsValid() || !v.CanInterface() {
+		return "", false
+	}
+	formatters.RLock()
+	fn, ok := formatters.m[v.Type()]
+	formatters.RUnlock()
+	if !ok {
+		return "", false
+	}
+	return fn(v.Interface()), true
+}
+
+// formatValue renders v with its registered formatter, or with %v.
+func formatValue(v reflect.Value) string {
+	if s, ok := formatted(v); ok {
+		return s
+	}
+	return fmt.Sprintf("%v", v)
+}
@@ -0,0 +1,129 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/internal/mock"
+)
+
+type Cents int64
+
+type Password string
+
+type Account struct {
+	Owner    string
+	Balance  Cents
+	Password Password
+}
+
+func TestRegisterFormatter(t *testing.T) {
+	defer assert.RegisterFormatter(func(c Cents) string {
+		return fmt.Sprintf("$%d.%02d", c/100, c%100)
+	})()
+	defer assert.RegisterFormatter(func(Password) string { return "***" })()
+
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"got (assert_test.Cents) $1.50 but expect (assert_test.Cents) $2.00"})
+		assert.That(g, Cents(150)).Equal(Cents(200))
+	})
+
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`got (assert_test.Password) *** but expect (assert_test.Password) ***
+message: secrets are redacted`})
+		assert.That(g, Password("a")).Equal(Password("b"), "secrets are redacted")
+	})
+
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`values differ in 1 places:
+    path    | got   | expect
+    Balance | $1.50 | $2.00`})
+		got := Account{Owner: "bob", Balance: 150, Password: "a"}
+		expect := Account{Owner: "bob", Balance: 200, Password: "a"}
+		assert.That(g, got).DiffReport(expect)
+	})
+
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`got (assert_test.Account) 
+assert_test.Account{
+  Owner: "bob",
+  Balance: $1.50,
+  Password: ***,
+} but expect (assert_test.Account) 
+assert_test.Account{
+  Owner: "bob",
+  Balance: $2.00,
+  Password: ***,
+}`})
+		a := assert.New(g).WithVerbosity(assert.VerbosityFull)
+		got := Account{Owner: "bob", Balance: 150, Password: "a"}
+		assert.That(a, got).Equal(Account{Owner: "bob", Balance: 200, Password: "a"})
+	})
+
+	t.Run("restore", func(t *testing.T) {
+		restore := assert.RegisterFormatter(func(c Cents) string { return "cents" })
+		runCase(t, func(g *mock.MockT) {
+			g.EXPECT().Error([]interface{}{"got (assert_test.Cents) cents but expect (assert_test.Cents) cents"})
+			assert.That(g, Cents(1)).Equal(Cents(2))
+		})
+		restore()
+		runCase(t, func(g *mock.MockT) {
+			g.EXPECT().Error([]interface{}{"got (assert_test.Cents) $0.01 but expect (assert_test.Cents) $0.02"})
+			assert.That(g, Cents(1)).Equal(Cents(2))
+		})
+	})
+}
+
+// discardT is a test handler discarding failures, so that the allocations
+// of building failure messages can be measured.
+type discardT struct{}
+
+func (discardT) Helper()                                   {}
+func (discardT) Error(args ...interface{})                 {}
+func (discardT) Errorf(format string, args ...interface{}) {}
+func (discardT) Fatal(args ...interface{})                 {}
+func (discardT) Fatalf(format string, args ...interface{}) {}
+func (discardT) Logf(format string, args ...interface{})   {}
+func (discardT) Name() string                              { return "" }
+func (discardT) Cleanup(fn func())                         {}
+func (discardT) Skip(args ...interface{})                  {}
+
+func TestFailureMessage_Allocs(t *testing.T) {
+	short, long := strings.Repeat("x", 1<<10), strings.Repeat("x", 32<<10)
+	// failure messages are built in pooled buffers, whose growth is not
+	// paid again for longer messages
+	n := testing.AllocsPerRun(100, func() {
+		assert.Fail(discardT{}, "%s", short)
+	})
+	assert.AllocsInDelta(t, n, 0, func() {
+		assert.Fail(discardT{}, "%s", long)
+	})
+}
+
+func BenchmarkFailureMessage(b *testing.B) {
+	defer assert.RegisterFormatter(func(c Cents) string {
+		return fmt.Sprintf("$%d.%02d", c/100, c%100)
+	})()
+	b.ReportAllocs()
+	for b.Loop() {
+		assert.That(discardT{}, Cents(150)).Equal(Cents(200), "balance")
+	}
+}
@@ -0,0 +1,282 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"slices"
+	"strings"
+
+	"github.com/lvan100/go-assert/internal"
+)
+
+// FSAssertion encapsulates an fs.FS and a test handler for making assertions
+// on the files and directories it contains. It works with any fs.FS
+// implementation, such as embed.FS, fstest.MapFS or a zip reader.
+type FSAssertion struct {
+	t    internal.T
+	fsys fs.FS
+}
+
+// ThatFS returns an FSAssertion for the given testing object and file system.
+func ThatFS(t internal.T, fsys fs.FS) *FSAssertion {
+	return &FSAssertion{
+		t:    chain(t),
+		fsys: fsys,
+	}
+}
+
+// Exists reports a test failure if the named file or directory does not exist.
+func (a *FSAssertion) Exists(name string, msg ...interface{}) *FSAssertion {
+	a.t.Helper()
+	defer track(a.t, nil)()
+	if _, err := fs.Stat(a.fsys, name); err != nil {
+		str := fmt.Sprintf(`path does not exist:
+   path: %q
+ expect: to exist
+  error: %v`, name, err)
+		fail(a.t, str, msg...)
+	}
+	return a
+}
+
+// NotExists reports a test failure if the named file or directory exists.
+func (a *FSAssertion) NotExists(name string, msg ...interface{}) *FSAssertion {
+	a.t.Helper()
+	defer track(a.t, nil)()
+	if _, err := fs.Stat(a.fsys, name); err == nil {
+		str := fmt.Sprintf(`path exists:
+   path: %q
+ expect: not to exist`, name)
+		fail(a.t, str, msg...)
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		str := fmt.Sprintf(`unable to stat path:
+   path: %q
+ expect: not to exist
+  error: %v`, name, err)
+		fail(a.t, str, msg...)
+	}
+	return a
+}
+
+// IsFile reports a test failure if the named path does not exist or is not a regular file.
+func (a *FSAssertion) IsFile(name string, msg ...interface{}) *FSAssertion {
+	a.t.Helper()
+	defer track(a.t, nil)()
+	info, err := fs.Stat(a.fsys, name)
+	if err != nil {
+		str := fmt.Sprintf(`path does not exist:
+   path: %q
+ expect: regular file
+  error: %v`, name, err)
+		fail(a.t, str, msg...)
+		return a
+	}
+	if !info.Mode().IsRegular() {
+		str := fmt.Sprintf(`path is not a regular file:
+   path: %q
+    got: mode %v
+ expect: regular file`, name, info.Mode())
+		fail(a.t, str, msg...)
+	}
+	return a
+}
+
+// IsDir reports a test failure if the named path does not exist or is not a directory.
+func (a *FSAssertion) IsDir(name string, msg ...interface{}) *FSAssertion {
+	a.t.Helper()
+	defer track(a.t, nil)()
+	info, err := fs.Stat(a.fsys, name)
+	if err != nil {
+		str := fmt.Sprintf(`path does not exist:
+   path: %q
+ expect: directory
+  error: %v`, name, err)
+		fail(a.t, str, msg...)
+		return a
+	}
+	if !info.IsDir() {
+		str := fmt.Sprintf(`path is not a directory:
+   path: %q
+    got: mode %v
+ expect: directory`, name, info.Mode())
+		fail(a.t, str, msg...)
+	}
+	return a
+}
+
+// HasSize reports a test failure if the named file's size is not equal to the expected size.
+func (a *FSAssertion) HasSize(name string, size int64, msg ...interface{}) *FSAssertion {
+	a.t.Helper()
+	defer track(a.t, nil)()
+	info, err := fs.Stat(a.fsys, name)
+	if err != nil {
+		str := fmt.Sprintf(`path does not exist:
+   path: %q
+ expect: size %d
+  error: %v`, name, size, err)
+		fail(a.t, str, msg...)
+		return a
+	}
+	if info.Size() != size {
+		str := fmt.Sprintf(`file size mismatch:
+   path: %q
+    got: size %d
+ expect: size %d`, name, info.Size(), size)
+		fail(a.t, str, msg...)
+	}
+	return a
+}
+
+// ContentEqual reports a test failure if the named file's content is not equal to the expected string.
+func (a *FSAssertion) ContentEqual(name string, expect string, msg ...interface{}) *FSAssertion {
+	a.t.Helper()
+	defer track(a.t, nil)()
+	b, ok := a.readFile(name, msg...)
+	if !ok {
+		return a
+	}
+	if got := string(b); got != expect {
+		str := fmt.Sprintf(`file content not equal:
+   path: %q
+    got: (%T) %q
+ expect: (%T) %q`, name, got, show(a.t, got), expect, show(a.t, expect))
+		failValues(a.t, got, expect, str, msg...)
+	}
+	return a
+}
+
+// ContentContains reports a test failure if the named file's content does not contain the substring.
+func (a *FSAssertion) ContentContains(name string, substr string, msg ...interface{}) *FSAssertion {
+	a.t.Helper()
+	defer track(a.t, nil)()
+	b, ok := a.readFile(name, msg...)
+	if !ok {
+		return a
+	}
+	if got := string(b); !strings.Contains(got, substr) {
+		str := fmt.Sprintf(`file content does not contain the specified substring:
+   path: %q
+    got: (%T) %q
+ expect: to contain substring %q`, name, got, show(a.t, got), show(a.t, substr))
+		fail(a.t, str, msg...)
+	}
+	return a
+}
+
+// ContentMatches reports a test failure if the named file's content does not match the regular expression.
+func (a *FSAssertion) ContentMatches(name string, expr string, msg ...interface{}) *FSAssertion {
+	a.t.Helper()
+	defer track(a.t, nil)()
+	b, ok := a.readFile(name, msg...)
+	if !ok {
+		return a
+	}
+	if ok, err := match(expr, b); !ok {
+		got := string(b)
+		str := fmt.Sprintf(`file content does not match the pattern:
+   path: %q
+    got: (%T) %q
+ expect: to match regex %q`, name, got, show(a.t, got), expr)
+		if err != nil {
+			str += fmt.Sprintf("\n  error: %v", err)
+		}
+		fail(a.t, str, msg...)
+	}
+	return a
+}
+
+// DirContains reports a test failure if the named directory does not contain all the given entries.
+func (a *FSAssertion) DirContains(dir string, names []string, msg ...interface{}) *FSAssertion {
+	a.t.Helper()
+	defer track(a.t, nil)()
+	entries, ok := a.readDir(dir, msg...)
+	if !ok {
+		return a
+	}
+	var missing []string
+	for _, name := range names {
+		if !slices.Contains(entries, name) {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		str := fmt.Sprintf(`directory does not contain the specified entries:
+   path: %q
+    got: %q
+ expect: to contain %q
+missing: %q`, dir, entries, names, missing)
+		fail(a.t, str, msg...)
+	}
+	return a
+}
+
+// DirEntries reports a test failure if the named directory's entries are not exactly the given names.
+// The order of names is not significant.
+func (a *FSAssertion) DirEntries(dir string, names []string, msg ...interface{}) *FSAssertion {
+	a.t.Helper()
+	defer track(a.t, nil)()
+	entries, ok := a.readDir(dir, msg...)
+	if !ok {
+		return a
+	}
+	expect := slices.Clone(names)
+	slices.Sort(expect)
+	if !slices.Equal(entries, expect) {
+		str := fmt.Sprintf(`directory entries not equal:
+   path: %q
+    got: %q
+ expect: %q`, dir, entries, show(a.t, expect))
+		fail(a.t, str, msg...)
+	}
+	return a
+}
+
+// readFile reads the named file, reporting a test failure if it cannot be read.
+func (a *FSAssertion) readFile(name string, msg ...interface{}) ([]byte, bool) {
+	a.t.Helper()
+	b, err := fs.ReadFile(a.fsys, name)
+	if err != nil {
+		str := fmt.Sprintf(`unable to read file:
+   path: %q
+  error: %v`, name, err)
+		fail(a.t, str, msg...)
+		return nil, false
+	}
+	return b, true
+}
+
+// readDir returns the sorted entry names of the named directory,
+// reporting a test failure if it cannot be read.
+func (a *FSAssertion) readDir(dir string, msg ...interface{}) ([]string, bool) {
+	a.t.Helper()
+	entries, err := fs.ReadDir(a.fsys, dir)
+	if err != nil {
+		str := fmt.Sprintf(`unable to read directory:
+   path: %q
+  error: %v`, dir, err)
+		fail(a.t, str, msg...)
+		return nil, false
+	}
+	names := make([]string, 0, len(entries))
+	for _, e := range entries {
+		names = append(names, e.Name())
+	}
+	return names, true
+}
@@ -0,0 +1,117 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert_test
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/internal/mock"
+)
+
+var testFS = fstest.MapFS{
+	"conf/app.yaml": {Data: []byte("name: app\nport: 8080\n")},
+	"conf/log.yaml": {Data: []byte("level: info\n")},
+	"README.md":     {Data: []byte("# readme")},
+}
+
+func TestFS_Exists(t *testing.T) {
+	runCase(t, func(g *mock.MockT) {
+		assert.ThatFS(g, testFS).Exists("conf/app.yaml").Exists("conf")
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`path does not exist:
+   path: "conf/db.yaml"
+ expect: to exist
+  error: open conf/db.yaml: file does not exist`})
+		assert.ThatFS(g, testFS).Exists("conf/db.yaml")
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`path exists:
+   path: "README.md"
+ expect: not to exist
+message: param (index=0)`})
+		assert.ThatFS(g, testFS).NotExists("conf/db.yaml").NotExists("README.md", "param (index=0)")
+	})
+}
+
+func TestFS_IsFile(t *testing.T) {
+	runCase(t, func(g *mock.MockT) {
+		assert.ThatFS(g, testFS).IsFile("README.md").IsDir("conf")
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`path is not a regular file:
+   path: "conf"
+    got: mode dr-xr-xr-x
+ expect: regular file`})
+		assert.ThatFS(g, testFS).IsFile("conf")
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`path is not a directory:
+   path: "README.md"
+    got: mode ----------
+ expect: directory`})
+		assert.ThatFS(g, testFS).IsDir("README.md")
+	})
+}
+
+func TestFS_Content(t *testing.T) {
+	runCase(t, func(g *mock.MockT) {
+		assert.ThatFS(g, testFS).
+			HasSize("README.md", 8).
+			ContentEqual("README.md", "# readme").
+			ContentContains("conf/app.yaml", "port: 8080").
+			ContentMatches("conf/log.yaml", `^level: \w+`)
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`file content not equal:
+   path: "README.md"
+    got: (string) "# readme"
+ expect: (string) "# README"`})
+		assert.ThatFS(g, testFS).ContentEqual("README.md", "# README")
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`unable to read file:
+   path: "conf"
+  error: read conf: invalid argument`})
+		assert.ThatFS(g, testFS).ContentContains("conf", "level")
+	})
+}
+
+func TestFS_DirEntries(t *testing.T) {
+	runCase(t, func(g *mock.MockT) {
+		assert.ThatFS(g, testFS).
+			DirContains("conf", []string{"log.yaml"}).
+			DirEntries("conf", []string{"log.yaml", "app.yaml"})
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`directory does not contain the specified entries:
+   path: "conf"
+    got: ["app.yaml" "log.yaml"]
+ expect: to contain ["db.yaml" "log.yaml"]
+missing: ["db.yaml"]`})
+		assert.ThatFS(g, testFS).DirContains("conf", []string{"db.yaml", "log.yaml"})
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`directory entries not equal:
+   path: "."
+    got: ["README.md" "conf"]
+ expect: ["README.md"]`})
+		assert.ThatFS(g, testFS).DirEntries(".", []string{"README.md"})
+	})
+}
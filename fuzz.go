@@ -0,0 +1,53 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/lvan100/go-assert/internal"
+)
+
+// fuzzInputOf returns the fuzz inputs of the assertions made through t, as
+// set by the outermost Asserter setting them.
+func fuzzInputOf(t internal.T) []interface{} {
+	for _, a := range asserterOf(t) {
+		if a.fuzzInput != nil {
+			return a.fuzzInput
+		}
+	}
+	return nil
+}
+
+// formatFuzzInput renders fuzz inputs as "  input:" lines of failure
+// messages.
+func formatFuzzInput(inputs []interface{}) string {
+	var sb strings.Builder
+	for _, v := range inputs {
+		switch v := v.(type) {
+		case string:
+			fmt.Fprintf(&sb, "\n  input: (string) %q hex %s", v, hex.EncodeToString([]byte(v)))
+		case []byte:
+			fmt.Fprintf(&sb, "\n  input: ([]uint8) %#v hex %s", v, hex.EncodeToString(v))
+		default:
+			fmt.Fprintf(&sb, "\n  input: (%T) %#v", v, v)
+		}
+	}
+	return sb.String()
+}
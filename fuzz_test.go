@@ -0,0 +1,37 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert_test
+
+import (
+	"testing"
+
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/internal/mock"
+)
+
+func TestAsserter_WithFuzzInput(t *testing.T) {
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`strings not equal:
+    got: (string) "a\x00"
+ expect: (string) "b"
+  input: (string) "a\x00" hex 6100
+  input: ([]uint8) []byte{0x1, 0xff} hex 01ff
+  input: (int) 3`})
+		a := assert.New(g).WithFuzzInput("a\x00", []byte{1, 0xff}, 3)
+		assert.ThatString(a, "a\x00").Equal("b")
+	})
+}
@@ -3,3 +3,7 @@ module github.com/lvan100/go-assert
 go 1.24
 
 require go.uber.org/mock v0.5.1
+
+require gopkg.in/yaml.v3 v3.0.1
+
+require github.com/google/go-cmp v0.7.0
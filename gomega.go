@@ -0,0 +1,108 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"fmt"
+	"strings"
+)
+
+// GomegaMatcher has the method set of the types.GomegaMatcher interface of
+// github.com/onsi/gomega, so that Gomega matchers can be used without this
+// package depending on Gomega, and the matchers returned by AsGomegaMatcher
+// can be passed to Gomega's Expect(...).To(...).
+type GomegaMatcher interface {
+	Match(actual interface{}) (success bool, err error)
+	FailureMessage(actual interface{}) (message string)
+	NegatedFailureMessage(actual interface{}) (message string)
+}
+
+// FromGomega returns a Matcher matching the values m matches, so that
+// existing Gomega matchers can be used with Match and ThatAssertion.Match:
+//
+//	assert.That(t, names).Match(assert.FromGomega(gomega.ContainElement("bob")))
+//
+// The failure message is the one of m, or the error m returns for values
+// it cannot match.
+func FromGomega(m GomegaMatcher) Matcher {
+	return MatcherFunc(func(v interface{}) (bool, string) {
+		ok, err := m.Match(v)
+		if err != nil {
+			return false, err.Error()
+		}
+		if ok {
+			return true, ""
+		}
+		return false, m.FailureMessage(v)
+	})
+}
+
+// gomegaMatcher is a GomegaMatcher making assertions, see AsGomegaMatcher.
+type gomegaMatcher[T any] struct {
+	desc string
+	fn   func(c *Collector, v T)
+}
+
+// AsGomegaMatcher returns a GomegaMatcher matching the values of type T for
+// which the assertions fn makes through its Collector pass, so that the
+// checks of this package can be used in Ginkgo suites:
+//
+//	Expect(user).To(assert.AsGomegaMatcher("a named user", func(c *assert.Collector, u *User) {
+//		assert.ThatString(c, u.Name).IsNotEmpty()
+//	}))
+//
+// Values that are not of type T make Match return an error.
+func AsGomegaMatcher[T any](desc string, fn func(c *Collector, v T)) GomegaMatcher {
+	return &gomegaMatcher[T]{desc: desc, fn: fn}
+}
+
+// check runs the assertions of m on actual and returns their failures.
+func (m *gomegaMatcher[T]) check(actual interface{}) ([]string, error) {
+	v, ok := actual.(T)
+	if !ok {
+		var zero T
+		return nil, fmt.Errorf("got (%T) but expect (%T)", actual, zero)
+	}
+	c := &Collector{t: PanicT()}
+	c.run(func(c *Collector) { m.fn(c, v) })
+	return c.failures, nil
+}
+
+// Match implements GomegaMatcher.
+func (m *gomegaMatcher[T]) Match(actual interface{}) (bool, error) {
+	failures, err := m.check(actual)
+	if err != nil {
+		return false, err
+	}
+	return len(failures) == 0, nil
+}
+
+// FailureMessage implements GomegaMatcher.
+func (m *gomegaMatcher[T]) FailureMessage(actual interface{}) string {
+	str := fmt.Sprintf("Expected\n    (%T) %v\nto be %s", actual, show(PanicT(), actual), m.desc)
+	if failures, err := m.check(actual); err != nil {
+		str += "\n" + err.Error()
+	} else if len(failures) > 0 {
+		str += "\n" + strings.Join(failures, "\n")
+	}
+	return str
+}
+
+// NegatedFailureMessage implements GomegaMatcher.
+func (m *gomegaMatcher[T]) NegatedFailureMessage(actual interface{}) string {
+	return fmt.Sprintf("Expected\n    (%T) %v\nnot to be %s", actual, show(PanicT(), actual), m.desc)
+}
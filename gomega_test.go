@@ -0,0 +1,81 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/internal/mock"
+)
+
+// haveLen is a Gomega-style matcher of the length of a slice.
+type haveLen int
+
+func (m haveLen) Match(actual interface{}) (bool, error) {
+	s, ok := actual.([]int)
+	if !ok {
+		return false, errors.New("HaveLen matcher expects a []int")
+	}
+	return len(s) == int(m), nil
+}
+
+func (m haveLen) FailureMessage(actual interface{}) string {
+	return fmt.Sprintf("Expected\n    %v\nto have length %d", actual, int(m))
+}
+
+func (m haveLen) NegatedFailureMessage(actual interface{}) string {
+	return fmt.Sprintf("Expected\n    %v\nnot to have length %d", actual, int(m))
+}
+
+func TestFromGomega(t *testing.T) {
+	runCase(t, func(g *mock.MockT) {
+		assert.That(g, []int{1, 2}).Match(assert.FromGomega(haveLen(2)))
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"value does not match:\n    got: ([]int) [1]\n reason: Expected\n    [1]\nto have length 2"})
+		assert.That(g, []int{1}).Match(assert.FromGomega(haveLen(2)))
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"value does not match:\n    got: (string) a\n reason: HaveLen matcher expects a []int"})
+		assert.Match(g, "a", assert.FromGomega(haveLen(2)))
+	})
+}
+
+func TestAsGomegaMatcher(t *testing.T) {
+	m := assert.AsGomegaMatcher("a short name", func(c *assert.Collector, s string) {
+		assert.ThatNumber(c, len(s)).LessThan(5)
+	})
+	ok, err := m.Match("bob")
+	assert.True(t, ok)
+	assert.Nil(t, err)
+	ok, err = m.Match("alexander")
+	assert.False(t, ok)
+	assert.Nil(t, err)
+	assert.That(t, m.FailureMessage("alexander")).Equal("Expected\n    (string) alexander\nto be a short name\ngot (int) 9 but expect less than (int) 5")
+	assert.That(t, m.NegatedFailureMessage("bob")).Equal("Expected\n    (string) bob\nnot to be a short name")
+	_, err = m.Match(3)
+	assert.ThatError(t, err).Matches(`got \(int\) but expect \(string\)`)
+
+	// long values are truncated as in failure messages
+	long := strings.Repeat("a", 2000)
+	assert.ThatString(t, m.FailureMessage(long)).Contains("... (976 more bytes)\nto be a short name")
+	assert.ThatString(t, m.NegatedFailureMessage(long)).Contains("... (976 more bytes)\nnot to be a short name")
+}
@@ -0,0 +1,141 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/lvan100/go-assert/internal"
+)
+
+// GomockMatcher has the methods of gomock.Matcher, to and from which it is
+// assignable. It is declared here so that this package, which also serves
+// non-test code through package check, does not import gomock.
+type GomockMatcher interface {
+	Matches(x interface{}) bool
+	String() string
+}
+
+// gomockMatcher is a gomock.Matcher making assertions, see AsGomockMatcher.
+type gomockMatcher[T any] struct {
+	desc string
+	fn   func(c *Collector, v T)
+
+	mu       sync.Mutex
+	failures []string // of the last mismatch
+}
+
+// AsGomockMatcher returns a gomock.Matcher matching the values of type T
+// for which the assertions fn makes through its Collector pass, so that the
+// predicates of tests also constrain the arguments of expected calls:
+//
+//	m.EXPECT().Save(assert.AsGomockMatcher("a named user", func(c *assert.Collector, u *User) {
+//		assert.ThatString(c, u.Name).IsNotEmpty()
+//	}))
+//
+// The matcher is described by desc, followed by the failures of the last
+// mismatch, which gomock prints with its own failure.
+func AsGomockMatcher[T any](desc string, fn func(c *Collector, v T)) GomockMatcher {
+	return &gomockMatcher[T]{desc: desc, fn: fn}
+}
+
+// Matches implements gomock.Matcher.
+func (m *gomockMatcher[T]) Matches(x interface{}) bool {
+	c := &Collector{t: PanicT()}
+	if v, ok := x.(T); ok {
+		c.run(func(c *Collector) { m.fn(c, v) })
+	} else {
+		var zero T
+		c.Error(fmt.Sprintf("got (%T) but expect (%T)", x, zero))
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.failures = c.failures
+	return !c.Failed()
+}
+
+// String implements gomock.Matcher.
+func (m *gomockMatcher[T]) String() string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if len(m.failures) == 0 {
+		return m.desc
+	}
+	return m.desc + "\n" + strings.Join(m.failures, "\n")
+}
+
+// matcherGomock is a gomock.Matcher matching the values a Matcher matches,
+// see MatcherAsGomock.
+type matcherGomock struct {
+	desc string
+	m    Matcher
+
+	mu      sync.Mutex
+	failure string // of the last mismatch
+}
+
+// MatcherAsGomock returns a gomock.Matcher matching the values m matches,
+// so that the matchers of tests, e.g. those of AllOf or FromGomega, also
+// constrain the arguments of expected calls:
+//
+//	m.EXPECT().Save(assert.MatcherAsGomock("an admin", isAdmin))
+//
+// The matcher is described by desc, followed by the failure message of the
+// last mismatch, which gomock prints with its own failure.
+func MatcherAsGomock(desc string, m Matcher) GomockMatcher {
+	return &matcherGomock{desc: desc, m: m}
+}
+
+// Matches implements gomock.Matcher.
+func (m *matcherGomock) Matches(x interface{}) bool {
+	ok, failure := m.m.Match(x)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.failure = ""
+	if !ok {
+		m.failure = failure
+	}
+	return ok
+}
+
+// String implements gomock.Matcher.
+func (m *matcherGomock) String() string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.failure == "" {
+		return m.desc
+	}
+	return m.desc + "\n" + m.failure
+}
+
+// MatchesGomock asserts that v matches the gomock.Matcher m, so that the
+// matchers of expected calls, e.g. gomock.Len or gomock.Regex, also check
+// values of tests. It reports an error describing m otherwise.
+func MatchesGomock(t internal.T, v interface{}, m GomockMatcher, msg ...interface{}) bool {
+	t.Helper()
+	defer track(t, v)()
+	if !m.Matches(v) {
+		str := fmt.Sprintf(`value does not match:
+    got: (%T) %v
+ expect: %s`, v, show(t, v), m)
+		fail(t, str, msg...)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,83 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/internal/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAsGomockMatcher(t *testing.T) {
+	m := assert.AsGomockMatcher("a short name", func(c *assert.Collector, s string) {
+		assert.ThatString(c, s).IsNotEmpty()
+		assert.ThatNumber(c, len(s)).LessThan(5)
+	})
+	assert.True(t, m.Matches("bob"))
+	assert.That(t, m.String()).Equal("a short name")
+	assert.False(t, m.Matches("alexander"))
+	assert.That(t, m.String()).Equal("a short name\ngot (int) 9 but expect less than (int) 5")
+	assert.False(t, m.Matches(3))
+	assert.That(t, m.String()).Equal("a short name\ngot (int) but expect (string)")
+
+	// constrains the arguments of expected calls
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Logf("%s", assert.AsGomockMatcher("a greeting", func(c *assert.Collector, v interface{}) {
+			assert.ThatString(c, v.(string)).HasPrefix("hello")
+		}))
+		g.Logf("%s", "hello, world")
+	})
+}
+
+func TestMatcherAsGomock(t *testing.T) {
+	short := assert.MatcherFunc(func(v interface{}) (bool, string) {
+		if s, ok := v.(string); ok && len(s) < 5 {
+			return true, ""
+		}
+		return false, fmt.Sprintf("%v is not a short string", v)
+	})
+	var m gomock.Matcher = assert.MatcherAsGomock("a short name", short)
+	assert.True(t, m.Matches("bob"))
+	assert.That(t, m.String()).Equal("a short name")
+	assert.False(t, m.Matches("alexander"))
+	assert.That(t, m.String()).Equal("a short name\nalexander is not a short string")
+	assert.True(t, m.Matches("eve"))
+	assert.That(t, m.String()).Equal("a short name")
+
+	// constrains the arguments of expected calls
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Logf("%s", assert.MatcherAsGomock("a short name", short))
+		g.Logf("%s", "bob")
+	})
+}
+
+func TestMatchesGomock(t *testing.T) {
+	runCase(t, func(g *mock.MockT) {
+		assert.MatchesGomock(g, []int{1, 2}, gomock.Len(2))
+		assert.MatchesGomock(g, "abc", gomock.Regex("^a"))
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`value does not match:
+    got: ([]int) [1]
+ expect: has length 2
+message: ids`})
+		assert.MatchesGomock(g, []int{1}, gomock.Len(2), "ids")
+	})
+}
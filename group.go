@@ -0,0 +1,131 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/lvan100/go-assert/internal"
+)
+
+// GroupRunner runs functions concurrently and turns their
+// returned errors and panics into assertion failures, reported by Wait on
+// the test goroutine. Created by Group.
+type GroupRunner struct {
+	t      internal.T
+	parent context.Context
+	ctx    context.Context
+	cancel context.CancelCauseFunc
+	wg     sync.WaitGroup
+
+	mu       sync.Mutex
+	failures []string
+	running  int
+}
+
+// Group returns a GroupRunner for the given testing object. The functions
+// it runs receive a context derived from ctx, canceled when ctx is done or
+// any of them fails, and Wait fails if they do not all complete before ctx
+// is done:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+//	defer cancel()
+//	g := assert.Group(t, ctx)
+//	g.Go(func(ctx context.Context) error { return server.Serve(ctx) })
+//	g.Go(func(ctx context.Context) error { return client.Ping(ctx) })
+//	g.Wait()
+func Group(t internal.T, ctx context.Context) *GroupRunner {
+	gctx, cancel := context.WithCancelCause(ctx)
+	return &GroupRunner{
+		t:      t,
+		parent: ctx,
+		ctx:    gctx,
+		cancel: cancel,
+	}
+}
+
+// record records a failure of a function run by the group.
+func (g *GroupRunner) record(str string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.failures = append(g.failures, str)
+}
+
+// Go runs fn in a new goroutine. A returned error or a panic is recorded as
+// a failure and cancels the context of the other functions.
+func (g *GroupRunner) Go(fn func(ctx context.Context) error) {
+	g.mu.Lock()
+	g.running++
+	g.mu.Unlock()
+	g.wg.Add(1)
+	go func() {
+		defer g.wg.Done()
+		defer func() {
+			if r := recover(); r != nil {
+				g.record(fmt.Sprintf("goroutine panicked: %v", r))
+				g.cancel(fmt.Errorf("panic: %v", r))
+			}
+			g.mu.Lock()
+			g.running--
+			g.mu.Unlock()
+		}()
+		if err := fn(g.ctx); err != nil {
+			g.record(fmt.Sprintf("goroutine returned error: %v", err))
+			g.cancel(err)
+		}
+	}()
+}
+
+// Wait waits for the functions run by the group and reports their failures.
+// If they do not all complete before the context given to Group is done,
+// it returns early, reporting the failures recorded so far and then a test
+// failure for the functions still running.
+func (g *GroupRunner) Wait(msg ...interface{}) bool {
+	g.t.Helper()
+	defer track(g.t, nil)()
+	done := make(chan struct{})
+	go func() {
+		g.wg.Wait()
+		g.cancel(nil)
+		close(done)
+	}()
+	completed := true
+	select {
+	case <-done:
+	case <-g.parent.Done():
+		select {
+		case <-done:
+		default:
+			completed = false
+		}
+	}
+	g.mu.Lock()
+	failures := append([]string{}, g.failures...)
+	running := g.running
+	g.mu.Unlock()
+	for _, str := range failures {
+		fail(g.t, str, msg...)
+	}
+	if !completed {
+		str := fmt.Sprintf("group did not complete: %v\nrunning: %d goroutine(s)", context.Cause(g.parent), running)
+		fail(g.t, str, msg...)
+		return false
+	}
+	return len(failures) == 0
+}
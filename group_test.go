@@ -0,0 +1,92 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/internal/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGroup(t *testing.T) {
+	runCase(t, func(g *mock.MockT) {
+		r := assert.Group(g, context.Background())
+		r.Go(func(ctx context.Context) error { return nil })
+		r.Go(func(ctx context.Context) error { return nil })
+		r.Wait()
+	})
+
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"goroutine returned error: boom\nmessage: workers"})
+		r := assert.Group(g, context.Background())
+		r.Go(func(ctx context.Context) error { return errors.New("boom") })
+		r.Go(func(ctx context.Context) error {
+			<-ctx.Done() // canceled by the failure of the first function
+			return nil
+		})
+		r.Wait("workers")
+	})
+
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"goroutine panicked: oops"})
+		r := assert.Group(g, context.Background())
+		r.Go(func(ctx context.Context) error { panic("oops") })
+		r.Wait()
+	})
+
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"group did not complete: context deadline exceeded\nrunning: 1 goroutine(s)"})
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		block := make(chan struct{})
+		defer close(block)
+		r := assert.Group(g, ctx)
+		r.Go(func(context.Context) error {
+			<-block
+			return nil
+		})
+		r.Wait()
+	})
+
+	runCase(t, func(g *mock.MockT) {
+		// the failures recorded before the timeout are reported with it
+		gomock.InOrder(
+			g.EXPECT().Error([]interface{}{"goroutine returned error: boom\nmessage: workers"}),
+			g.EXPECT().Error([]interface{}{"group did not complete: context deadline exceeded\nrunning: 1 goroutine(s)\nmessage: workers"}),
+		)
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		block := make(chan struct{})
+		defer close(block)
+		canceled := make(chan struct{})
+		r := assert.Group(g, ctx)
+		r.Go(func(context.Context) error { return errors.New("boom") })
+		r.Go(func(ctx context.Context) error {
+			<-ctx.Done() // canceled once the failure is recorded
+			close(canceled)
+			<-block
+			return nil
+		})
+		<-canceled
+		assert.ThatBool(t, r.Wait("workers")).IsFalse()
+	})
+}
@@ -0,0 +1,17 @@
+module github.com/lvan100/go-assert/grpcassert
+
+go 1.24.0
+
+require (
+	github.com/lvan100/go-assert v1.0.0
+	go.uber.org/mock v0.5.1
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20260120221211-b8f7ae30c516
+	google.golang.org/grpc v1.80.0
+)
+
+require (
+	github.com/google/go-cmp v0.7.0 // indirect
+	golang.org/x/sys v0.40.0 // indirect
+	google.golang.org/protobuf v1.36.11 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
@@ -0,0 +1,128 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package grpcassert offers assertions on gRPC status errors, kept apart
+// from package assert so that only the tests using them depend on gRPC:
+//
+//	a := grpcassert.ThatError(t, err).CodeIs(codes.InvalidArgument)
+//	d := grpcassert.HasDetail[*errdetails.BadRequest](a)
+package grpcassert
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/lvan100/go-assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrorAssertion encapsulates an error returned by a gRPC call and a test
+// handler for making assertions on its status code, message and details.
+// Wrapped status errors are unwrapped as by status.FromError.
+type ErrorAssertion struct {
+	t   assert.T
+	err error
+	s   *status.Status
+}
+
+// ThatError returns an ErrorAssertion for the given testing object and error.
+func ThatError(t assert.T, err error) *ErrorAssertion {
+	a := &ErrorAssertion{t: assert.Chain(t), err: err}
+	if s, ok := status.FromError(err); ok && err != nil {
+		a.s = s
+	}
+	return a
+}
+
+// valid returns a failure if the error is nil or carries no gRPC status.
+func (a *ErrorAssertion) valid() assert.Result {
+	if a.err == nil {
+		return assert.ResultFailure("expect non-nil gRPC error")
+	}
+	if a.s == nil {
+		return assert.ResultFailure(`error is not a gRPC status error:
+    got: (%T) %v`, a.err, a.err)
+	}
+	return assert.ResultSuccess()
+}
+
+// CodeIs reports a test failure if the status code is not equal to the expected code.
+func (a *ErrorAssertion) CodeIs(code codes.Code, msg ...interface{}) *ErrorAssertion {
+	a.t.Helper()
+	assert.Check(a.t, func() assert.Result {
+		if r := a.valid(); !r.Success() {
+			return r
+		}
+		if a.s.Code() != code {
+			return assert.ResultFailure(`gRPC status code mismatch:
+   desc: %q
+    got: %v
+ expect: %v`, a.s.Message(), a.s.Code(), code)
+		}
+		return assert.ResultSuccess()
+	}, msg...)
+	return a
+}
+
+// MessageContains reports a test failure if the status message does not contain the substring.
+func (a *ErrorAssertion) MessageContains(substr string, msg ...interface{}) *ErrorAssertion {
+	a.t.Helper()
+	assert.Check(a.t, func() assert.Result {
+		if r := a.valid(); !r.Success() {
+			return r
+		}
+		if got := a.s.Message(); !strings.Contains(got, substr) {
+			return assert.ResultFailure(`gRPC status message does not contain the specified substring:
+   code: %v
+    got: %q
+ expect: to contain substring %q`, a.s.Code(), got, substr)
+		}
+		return assert.ResultSuccess()
+	}, msg...)
+	return a
+}
+
+// HasDetail reports a test failure if the status of a carries no detail of
+// type T, e.g. *errdetails.BadRequest, and returns the first such detail.
+// It is a function rather than a method because Go methods cannot have
+// type parameters.
+func HasDetail[T any](a *ErrorAssertion, msg ...interface{}) T {
+	a.t.Helper()
+	var detail T
+	assert.Check(a.t, func() assert.Result {
+		if r := a.valid(); !r.Success() {
+			return r
+		}
+		var types []string
+		for _, d := range a.s.Details() {
+			if v, ok := d.(T); ok {
+				detail = v
+				return assert.ResultSuccess()
+			}
+			if err, ok := d.(error); ok {
+				types = append(types, "error: "+err.Error())
+			} else {
+				types = append(types, fmt.Sprintf("%T", d))
+			}
+		}
+		return assert.ResultFailure(`gRPC status detail not found:
+   code: %v
+    got: %q
+ expect: detail of type %T`, a.s.Code(), types, detail)
+	}, msg...)
+	return detail
+}
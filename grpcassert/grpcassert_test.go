@@ -0,0 +1,87 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package grpcassert_test
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/grpcassert"
+	"github.com/lvan100/go-assert/internal/mock"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMain(m *testing.M) {
+	assert.Configure(func(s *assert.Settings) {
+		s.Color = assert.ColorNever
+	})
+	os.Exit(m.Run())
+}
+
+func runCase(t *testing.T, f func(g *mock.MockT)) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	g := mock.NewMockT(ctrl)
+	g.EXPECT().Helper().AnyTimes()
+	f(g)
+}
+
+func TestThatError(t *testing.T) {
+	s, _ := status.New(codes.InvalidArgument, "invalid user name").WithDetails(&errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{
+			{Field: "name", Description: "must not be empty"},
+		},
+	})
+	err := fmt.Errorf("create user: %w", s.Err())
+
+	runCase(t, func(g *mock.MockT) {
+		a := grpcassert.ThatError(g, err).CodeIs(codes.InvalidArgument).MessageContains("user name")
+		d := grpcassert.HasDetail[*errdetails.BadRequest](a)
+		assert.That(g, d.GetFieldViolations()[0].GetField()).Equal("name")
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`gRPC status code mismatch:
+   desc: "create user: rpc error: code = InvalidArgument desc = invalid user name"
+    got: InvalidArgument
+ expect: NotFound`})
+		g.EXPECT().Error([]interface{}{`gRPC status message does not contain the specified substring:
+   code: InvalidArgument
+    got: "create user: rpc error: code = InvalidArgument desc = invalid user name"
+ expect: to contain substring "not found"`})
+		g.EXPECT().Error([]interface{}{`gRPC status detail not found:
+   code: InvalidArgument
+    got: ["*errdetails.BadRequest"]
+ expect: detail of type *errdetails.RetryInfo`})
+		a := grpcassert.ThatError(assert.New(g).WithContinueChains(true), err).CodeIs(codes.NotFound).MessageContains("not found")
+		grpcassert.HasDetail[*errdetails.RetryInfo](a)
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"expect non-nil gRPC error"})
+		grpcassert.ThatError(g, nil).CodeIs(codes.OK)
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`error is not a gRPC status error:
+    got: (*errors.errorString) boom`})
+		grpcassert.ThatError(g, errors.New("boom")).CodeIs(codes.Unknown)
+	})
+}
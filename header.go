@@ -0,0 +1,196 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"fmt"
+	"mime"
+	"net/http"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/lvan100/go-assert/internal"
+)
+
+// HeaderAssertion encapsulates an HTTP header map and a test handler for
+// making assertions on it. Keys are canonicalized, so entries stored under
+// non-canonical keys are still found. When an expected key is missing, the
+// failure message lists present keys that look like a typo of it.
+type HeaderAssertion struct {
+	t internal.T
+	h http.Header
+}
+
+// ThatHeader returns a HeaderAssertion for the given testing object and header.
+func ThatHeader(t internal.T, h http.Header) *HeaderAssertion {
+	c := make(http.Header, len(h))
+	for k, v := range h {
+		key := http.CanonicalHeaderKey(k)
+		c[key] = append(c[key], v...)
+	}
+	return &HeaderAssertion{
+		t: chain(t),
+		h: c,
+	}
+}
+
+// missing reports a test failure for a key that is not present.
+func (a *HeaderAssertion) missing(key string, msg ...interface{}) {
+	a.t.Helper()
+	str := fmt.Sprintf(`header not found:
+ header: %q`, key)
+	if near := a.nearMiss(key); len(near) > 0 {
+		str += fmt.Sprintf("\nsimilar: %q", near)
+	}
+	fail(a.t, str, msg...)
+}
+
+// nearMiss returns the sorted present keys that are likely typos of key:
+// those equal to it ignoring '-' and '_', or within edit distance 2.
+func (a *HeaderAssertion) nearMiss(key string) []string {
+	squash := func(s string) string {
+		return strings.NewReplacer("-", "", "_", "").Replace(strings.ToLower(s))
+	}
+	var near []string
+	for k := range a.h {
+		if squash(k) == squash(key) || editDistance(strings.ToLower(k), strings.ToLower(key)) <= 2 {
+			near = append(near, k)
+		}
+	}
+	sort.Strings(near)
+	return near
+}
+
+// Has reports a test failure if the header key is not present.
+func (a *HeaderAssertion) Has(key string, msg ...interface{}) *HeaderAssertion {
+	a.t.Helper()
+	defer track(a.t, nil)()
+	key = http.CanonicalHeaderKey(key)
+	if _, ok := a.h[key]; !ok {
+		a.missing(key, msg...)
+	}
+	return a
+}
+
+// NotHas reports a test failure if the header key is present.
+func (a *HeaderAssertion) NotHas(key string, msg ...interface{}) *HeaderAssertion {
+	a.t.Helper()
+	defer track(a.t, nil)()
+	key = http.CanonicalHeaderKey(key)
+	if v, ok := a.h[key]; ok {
+		str := fmt.Sprintf(`header is present:
+ header: %q
+    got: %q
+ expect: not present`, key, v)
+		fail(a.t, str, msg...)
+	}
+	return a
+}
+
+// Equal reports a test failure if the first value of the header key is not
+// equal to the expected value.
+func (a *HeaderAssertion) Equal(key string, expect string, msg ...interface{}) *HeaderAssertion {
+	a.t.Helper()
+	defer track(a.t, nil)()
+	key = http.CanonicalHeaderKey(key)
+	v, ok := a.h[key]
+	if !ok {
+		a.missing(key, msg...)
+		return a
+	}
+	if v[0] != expect {
+		str := fmt.Sprintf(`header mismatch:
+ header: %q
+    got: %q
+ expect: %q`, key, v[0], expect)
+		fail(a.t, str, msg...)
+	}
+	return a
+}
+
+// HasValues reports a test failure if the values of the header key are not
+// exactly the expected values, in order.
+func (a *HeaderAssertion) HasValues(key string, expect ...string) *HeaderAssertion {
+	a.t.Helper()
+	defer track(a.t, nil)()
+	key = http.CanonicalHeaderKey(key)
+	v, ok := a.h[key]
+	if !ok {
+		a.missing(key)
+		return a
+	}
+	if !slices.Equal(v, expect) {
+		str := fmt.Sprintf(`header values mismatch:
+ header: %q
+    got: %q
+ expect: %q`, key, v, expect)
+		fail(a.t, str)
+	}
+	return a
+}
+
+// ContentTypeIs reports a test failure if the media type of the Content-Type
+// header is not equal to the expected one. Parameters such as charset are
+// ignored and the comparison is case-insensitive.
+func (a *HeaderAssertion) ContentTypeIs(mediaType string, msg ...interface{}) *HeaderAssertion {
+	a.t.Helper()
+	defer track(a.t, nil)()
+	const key = "Content-Type"
+	v, ok := a.h[key]
+	if !ok {
+		a.missing(key, msg...)
+		return a
+	}
+	got, _, err := mime.ParseMediaType(v[0])
+	if err != nil {
+		str := fmt.Sprintf(`invalid content type:
+    got: %q
+ expect: %q
+  error: %v`, v[0], mediaType, err)
+		fail(a.t, str, msg...)
+		return a
+	}
+	if !strings.EqualFold(got, mediaType) {
+		str := fmt.Sprintf(`content type mismatch:
+    got: %q
+ expect: %q`, v[0], mediaType)
+		fail(a.t, str, msg...)
+	}
+	return a
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
@@ -0,0 +1,84 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/internal/mock"
+)
+
+func TestHeader(t *testing.T) {
+	h := http.Header{
+		"Content-Type": {"application/json; charset=utf-8"},
+		"Vary":         {"Accept", "Accept-Encoding"},
+		"x-request-id": {"42"}, // non-canonical key set directly on the map
+	}
+
+	runCase(t, func(g *mock.MockT) {
+		assert.ThatHeader(g, h).
+			Has("X-Request-Id").
+			NotHas("Location").
+			Equal("x-request-id", "42").
+			HasValues("vary", "Accept", "Accept-Encoding").
+			ContentTypeIs("application/json")
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`header not found:
+ header: "X-Requestid"
+similar: ["X-Request-Id"]`})
+		g.EXPECT().Error([]interface{}{`header not found:
+ header: "Etag"`})
+		assert.ThatHeader(assert.New(g).WithContinueChains(true), h).Has("X-RequestId").Equal("ETag", `"abc"`)
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`header mismatch:
+ header: "X-Request-Id"
+    got: "42"
+ expect: "43"`})
+		g.EXPECT().Error([]interface{}{`header values mismatch:
+ header: "Vary"
+    got: ["Accept" "Accept-Encoding"]
+ expect: ["Accept"]`})
+		g.EXPECT().Error([]interface{}{`header is present:
+ header: "Vary"
+    got: ["Accept" "Accept-Encoding"]
+ expect: not present`})
+		assert.ThatHeader(assert.New(g).WithContinueChains(true), h).Equal("X-Request-Id", "43").HasValues("Vary", "Accept").NotHas("Vary")
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`content type mismatch:
+    got: "application/json; charset=utf-8"
+ expect: "text/plain"`})
+		assert.ThatHeader(g, h).ContentTypeIs("text/plain")
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`invalid content type:
+    got: ";"
+ expect: "text/plain"
+  error: mime: no media type`})
+		assert.ThatHeader(g, http.Header{"Content-Type": {";"}}).ContentTypeIs("text/plain")
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`header not found:
+ header: "Content-Type"
+similar: ["Content-Typ"]`})
+		assert.ThatHeader(g, http.Header{"Content-Typ": {"text/plain"}}).ContentTypeIs("text/plain")
+	})
+}
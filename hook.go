@@ -0,0 +1,96 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"runtime"
+	"sync"
+
+	"github.com/lvan100/go-assert/internal"
+)
+
+// failureHooks holds the hooks registered with OnFailure by each test,
+// keyed by its innermost test handler.
+var failureHooks sync.Map // map[internal.T]*hookList
+
+// hookList is the list of failure hooks of a test.
+type hookList struct {
+	mu  sync.Mutex
+	fns []func(f Failure)
+}
+
+// OnFailure registers fn to be called with each failure of an assertion
+// made through t until the test ends, e.g. to dump server logs or take a
+// database snapshot next to the failure:
+//
+//	assert.OnFailure(t, func(f assert.Failure) {
+//		t.Logf("server logs:\n%s", srv.Logs())
+//	})
+//
+// Hooks are called in registration order, after reporters and before the
+// failure is reported to the test. Failures of assertions made by hooks
+// do not call hooks again. Hooks registered for a test handler that never
+// ends, such as PanicT, are ignored.
+func OnFailure(t internal.T, fn func(f Failure)) {
+	if endless(t) {
+		return
+	}
+	key := baseT(t)
+	v, loaded := failureHooks.LoadOrStore(key, &hookList{})
+	if !loaded {
+		t.Cleanup(func() { failureHooks.Delete(key) })
+	}
+	l := v.(*hookList)
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.fns = append(l.fns, fn)
+}
+
+// runFailureHooks calls the failure hooks of the test of t with f, unless
+// it is called by one of them: the failures of the assertions made by a
+// hook do not run the hooks again, while concurrent failures of other
+// goroutines do.
+func runFailureHooks(t internal.T, f Failure) {
+	v, ok := failureHooks.Load(baseT(t))
+	if !ok || inFailureHook() {
+		return
+	}
+	l := v.(*hookList)
+	l.mu.Lock()
+	fns := l.fns[:len(l.fns):len(l.fns)]
+	l.mu.Unlock()
+	for _, fn := range fns {
+		fn(f)
+	}
+}
+
+// inFailureHook reports whether runFailureHooks, which calls it, is already
+// on the stack of its goroutine.
+func inFailureHook() bool {
+	pc := make([]uintptr, maxStackDepth)
+	n := runtime.Callers(3, pc)
+	frames := runtime.CallersFrames(pc[:n])
+	for {
+		frame, more := frames.Next()
+		if frame.Function == pkgPath+"runFailureHooks" {
+			return true
+		}
+		if !more {
+			return false
+		}
+	}
+}
@@ -0,0 +1,78 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert_test
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/lvan100/go-assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestOnFailure(t *testing.T) {
+	var ops []string
+	runNamedCase(t, "TestServer", func(g *namedT) {
+		g.EXPECT().Error(gomock.Any()).Times(2)
+		assert.OnFailure(g, func(f assert.Failure) {
+			ops = append(ops, f.Op)
+			// failures of hooks do not call hooks again
+			assert.True(g, false)
+		})
+		assert.True(g, true)
+		assert.ThatString(assert.New(g), "a").Equal("b")
+	})
+	assert.ThatSlice(t, ops).Equal([]string{"StringAssertion.Equal"})
+
+	// hooks end with their test
+	runNamedCase(t, "TestServer", func(g *namedT) {
+		g.EXPECT().Error(gomock.Any())
+		assert.False(g, true)
+	})
+	assert.ThatNumber(t, len(ops)).Equal(1)
+}
+
+func TestOnFailure_Concurrent(t *testing.T) {
+	var calls atomic.Int32
+	second := make(chan struct{})
+	runNamedCase(t, "TestServer", func(g *namedT) {
+		g.EXPECT().Error(gomock.Any()).Times(2)
+		assert.OnFailure(g, func(f assert.Failure) {
+			// the first hook waits for the failure of the other goroutine
+			if calls.Add(1) == 1 {
+				select {
+				case <-second:
+				case <-time.After(time.Second):
+				}
+			} else {
+				close(second)
+			}
+		})
+		var wg sync.WaitGroup
+		for range 2 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				assert.True(g, false)
+			}()
+		}
+		wg.Wait()
+	})
+	assert.ThatNumber(t, calls.Load()).Equal(int32(2))
+}
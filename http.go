@@ -0,0 +1,537 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/lvan100/go-assert/internal"
+)
+
+// ResponseRecorder is implemented by recorders of the responses of
+// handlers, such as *httptest.ResponseRecorder.
+type ResponseRecorder interface {
+	Result() *http.Response
+}
+
+// ResponseAssertion encapsulates an HTTP response and a test handler for
+// making assertions on its status, headers and body. The body is read at
+// most once, the original body is closed, and resp.Body is replaced with
+// an in-memory copy so that it can still be read after the assertions.
+//
+// For huge bodies, LimitedTo bounds the number of bytes that are buffered
+// and BodyStream compares the body without buffering it at all.
+//
+// Bodies with a "Content-Encoding: gzip" header are decoded before they are
+// compared, while resp.Body is restored with the encoded bytes.
+type ResponseAssertion struct {
+	t         internal.T
+	resp      *http.Response
+	body      []byte
+	bodyErr   error
+	read      bool
+	limit     int64 // negative means unlimited
+	truncated bool  // the body is longer than limit
+	encoded   int64 // number of encoded bytes read, if gzip encoded
+}
+
+// ThatResponse returns a ResponseAssertion for the given testing object and
+// response, which may be an *http.Response or a ResponseRecorder, such as an
+// *httptest.ResponseRecorder. It reports a test failure for other types.
+func ThatResponse(t internal.T, resp interface{}) *ResponseAssertion {
+	t.Helper()
+	a := &ResponseAssertion{t: chain(t), limit: -1}
+	switch r := resp.(type) {
+	case *http.Response:
+		a.resp = r
+	case ResponseRecorder:
+		if !isNil(reflect.ValueOf(r)) {
+			a.resp = r.Result()
+		}
+	case nil:
+	default:
+		fail(a.t, fmt.Sprintf("unsupported response type (%T)", resp))
+	}
+	return a
+}
+
+// LimitedTo returns a ResponseAssertion whose body assertions read and
+// compare at most the first n bytes of the body. The rest of the body is
+// discarded unread, and BodyEqual also only considers the first n bytes of
+// the expected content. It must be called before the body is read.
+func (a *ResponseAssertion) LimitedTo(n int64) *ResponseAssertion {
+	return &ResponseAssertion{
+		t:     a.t,
+		resp:  a.resp,
+		limit: n,
+	}
+}
+
+// note returns the lines appended to failure messages of truncated or
+// decoded bodies.
+func (a *ResponseAssertion) note() string {
+	var note string
+	if a.truncated {
+		note = fmt.Sprintf("\n   note: body truncated to the first %d bytes", a.limit)
+	}
+	if a.gzipEncoded() {
+		note += compressionNote("gzip", a.encoded)
+	}
+	return note
+}
+
+// gzipEncoded reports whether the response body is gzip encoded according to
+// its Content-Encoding header.
+func (a *ResponseAssertion) gzipEncoded() bool {
+	switch strings.ToLower(strings.TrimSpace(a.resp.Header.Get("Content-Encoding"))) {
+	case "gzip", "x-gzip":
+		return true
+	}
+	return false
+}
+
+// valid reports a test failure if there is no response to assert on.
+func (a *ResponseAssertion) valid(msg ...interface{}) bool {
+	a.t.Helper()
+	if a.resp == nil {
+		fail(a.t, "expect not nil response", msg...)
+		return false
+	}
+	return true
+}
+
+// readBody reads and caches the response body, restoring resp.Body.
+func (a *ResponseAssertion) readBody(msg ...interface{}) ([]byte, bool) {
+	a.t.Helper()
+	if !a.valid(msg...) {
+		return nil, false
+	}
+	if !a.read {
+		a.read = true
+		body := a.resp.Body
+		var gz *gzipBody
+		if a.gzipEncoded() && body != nil && body != http.NoBody {
+			gz = &gzipBody{rc: body}
+			body = gz
+		}
+		if a.limit >= 0 {
+			a.body, a.truncated, a.resp.Body, a.bodyErr = readLimited(body, a.limit)
+		} else {
+			a.body, a.resp.Body, a.bodyErr = readAndRestore(body)
+		}
+		if gz != nil {
+			a.encoded = int64(gz.raw.Len())
+			a.resp.Body = io.NopCloser(bytes.NewReader(gz.raw.Bytes()))
+		}
+	}
+	if a.bodyErr != nil {
+		str := fmt.Sprintf(`unable to read response body:
+  error: %v`, a.bodyErr)
+		fail(a.t, str, msg...)
+		return nil, false
+	}
+	return a.body, true
+}
+
+// StatusIs reports a test failure if the response status code is not equal to the expected code.
+func (a *ResponseAssertion) StatusIs(code int, msg ...interface{}) *ResponseAssertion {
+	a.t.Helper()
+	defer track(a.t, nil)()
+	if !a.valid(msg...) {
+		return a
+	}
+	if a.resp.StatusCode != code {
+		str := fmt.Sprintf(`status code mismatch:
+    got: %d %s
+ expect: %d %s`, a.resp.StatusCode, http.StatusText(a.resp.StatusCode), code, http.StatusText(code))
+		fail(a.t, str, msg...)
+	}
+	return a
+}
+
+// HeaderEqual reports a test failure if the first value of the response
+// header key is not equal to the expected value.
+func (a *ResponseAssertion) HeaderEqual(key string, expect string, msg ...interface{}) *ResponseAssertion {
+	a.t.Helper()
+	defer track(a.t, nil)()
+	if !a.valid(msg...) {
+		return a
+	}
+	if got := a.resp.Header.Get(key); got != expect {
+		str := fmt.Sprintf(`header mismatch:
+ header: %q
+    got: %q
+ expect: %q`, http.CanonicalHeaderKey(key), show(a.t, got), show(a.t, expect))
+		failValues(a.t, got, expect, str, msg...)
+	}
+	return a
+}
+
+// Header returns a HeaderAssertion over the response headers.
+func (a *ResponseAssertion) Header(msg ...interface{}) *HeaderAssertion {
+	a.t.Helper()
+	if !a.valid(msg...) {
+		return ThatHeader(a.t, nil)
+	}
+	return ThatHeader(a.t, a.resp.Header)
+}
+
+// Location returns a URLAssertion over the Location header, resolved
+// relative to the request URL when the response carries its request.
+// A test failure is reported if the header is missing or invalid.
+func (a *ResponseAssertion) Location(msg ...interface{}) *URLAssertion {
+	a.t.Helper()
+	if !a.valid(msg...) {
+		return &URLAssertion{t: a.t}
+	}
+	u, err := a.resp.Location()
+	if err != nil {
+		str := fmt.Sprintf(`invalid Location header:
+    got: %q
+  error: %v`, a.resp.Header.Get("Location"), err)
+		fail(a.t, str, msg...)
+		return &URLAssertion{t: a.t}
+	}
+	return ThatURL(a.t, u)
+}
+
+// BodyEqual reports a test failure if the response body is not equal to the expected string.
+func (a *ResponseAssertion) BodyEqual(expect string, msg ...interface{}) *ResponseAssertion {
+	a.t.Helper()
+	defer track(a.t, nil)()
+	b, ok := a.readBody(msg...)
+	if !ok {
+		return a
+	}
+	if a.limit >= 0 && int64(len(expect)) > a.limit {
+		expect = expect[:a.limit]
+	}
+	if got := string(b); got != expect {
+		str := fmt.Sprintf(`response body not equal:
+    got: (%T) %q
+ expect: (%T) %q`, got, show(a.t, got), expect, show(a.t, expect))
+		failValues(a.t, got, expect, str+a.note(), msg...)
+	}
+	return a
+}
+
+// BodyContains reports a test failure if the response body does not contain the substring.
+func (a *ResponseAssertion) BodyContains(substr string, msg ...interface{}) *ResponseAssertion {
+	a.t.Helper()
+	defer track(a.t, nil)()
+	b, ok := a.readBody(msg...)
+	if !ok {
+		return a
+	}
+	if got := string(b); !strings.Contains(got, substr) {
+		str := fmt.Sprintf(`response body does not contain the specified substring:
+    got: (%T) %q
+ expect: to contain substring %q`, got, show(a.t, got), show(a.t, substr))
+		fail(a.t, str+a.note(), msg...)
+	}
+	return a
+}
+
+// BodyJSONEqual reports a test failure if the response body and the expected
+// string are not equivalent JSON documents.
+func (a *ResponseAssertion) BodyJSONEqual(expect string, msg ...interface{}) *ResponseAssertion {
+	a.t.Helper()
+	defer track(a.t, nil)()
+	b, ok := a.readBody(msg...)
+	if !ok {
+		return a
+	}
+	bodyJSONEqual(a.t, "response", b, expect, a.note(), msg...)
+	return a
+}
+
+// BodyStream returns a ReaderAssertion that compares the response body as
+// it is read from the connection, without buffering it in memory. The body
+// is consumed and closed at EOF, so it cannot be read again afterwards.
+// If LimitedTo is in effect, the stream is limited accordingly.
+func (a *ResponseAssertion) BodyStream(msg ...interface{}) *ReaderAssertion {
+	a.t.Helper()
+	if !a.valid(msg...) {
+		return ThatReader(a.t, bytes.NewReader(nil))
+	}
+	var r io.Reader = bytes.NewReader(a.body)
+	if !a.read && a.resp.Body != nil {
+		r = &closingReader{rc: a.resp.Body}
+		a.read = true
+		a.resp.Body = http.NoBody
+	}
+	ra := ThatReader(a.t, r)
+	if _, ok := r.(*closingReader); ok && a.gzipEncoded() {
+		ra = ra.Decompressed(msg...)
+	}
+	if a.limit >= 0 {
+		ra = ra.LimitedTo(a.limit)
+	}
+	return ra
+}
+
+// closingReader closes the underlying reader once it returns an error,
+// typically io.EOF, and keeps returning that error afterwards.
+type closingReader struct {
+	rc  io.ReadCloser
+	err error
+}
+
+func (c *closingReader) Read(p []byte) (int, error) {
+	if c.err != nil {
+		return 0, c.err
+	}
+	n, err := c.rc.Read(p)
+	if err != nil {
+		c.err = err
+		_ = c.rc.Close()
+	}
+	return n, err
+}
+
+// gzipBody decodes a gzip encoded body, keeping a copy of the encoded bytes
+// read so that they can be restored.
+type gzipBody struct {
+	rc  io.ReadCloser
+	raw bytes.Buffer
+	zr  *gzip.Reader
+}
+
+func (g *gzipBody) Read(p []byte) (int, error) {
+	if g.zr == nil {
+		zr, err := gzip.NewReader(io.TeeReader(g.rc, &g.raw))
+		if err != nil {
+			return 0, err
+		}
+		g.zr = zr
+	}
+	return g.zr.Read(p)
+}
+
+func (g *gzipBody) Close() error {
+	return g.rc.Close()
+}
+
+// Body returns a StringAssertion over the response body, giving access to
+// the full set of string assertions.
+func (a *ResponseAssertion) Body(msg ...interface{}) *StringAssertion {
+	a.t.Helper()
+	b, _ := a.readBody(msg...)
+	return ThatString(a.t, string(b))
+}
+
+// readAndRestore reads and closes body, returning its content together with
+// an in-memory replacement so that the body can be read again afterwards.
+func readAndRestore(body io.ReadCloser) ([]byte, io.ReadCloser, error) {
+	var b []byte
+	var err error
+	if body != nil && body != http.NoBody {
+		b, err = io.ReadAll(body)
+		_ = body.Close()
+	}
+	return b, io.NopCloser(bytes.NewReader(b)), err
+}
+
+// readLimited reads at most limit bytes of body and closes it without
+// reading the rest. It reports whether the body was longer than limit and
+// returns an in-memory replacement holding the bytes that were read.
+func readLimited(body io.ReadCloser, limit int64) ([]byte, bool, io.ReadCloser, error) {
+	var b []byte
+	var err error
+	truncated := false
+	if body != nil && body != http.NoBody {
+		b, err = io.ReadAll(io.LimitReader(body, limit+1))
+		if int64(len(b)) > limit {
+			b, truncated = b[:limit], true
+		}
+		_ = body.Close()
+	}
+	return b, truncated, io.NopCloser(bytes.NewReader(b)), err
+}
+
+// bodyJSONEqual reports a test failure if the body and the expected string
+// are not equivalent JSON documents. kind is "request" or "response", and
+// note is appended to failure messages.
+func bodyJSONEqual(t internal.T, kind string, b []byte, expect string, note string, msg ...interface{}) {
+	t.Helper()
+	got := string(b)
+	gotJson, err := canonicalJSON(b)
+	if err != nil {
+		str := fmt.Sprintf(`invalid JSON in %s body:
+    got: (%T) %q
+  error: %v`, kind, got, show(t, got), err)
+		fail(t, str+note, msg...)
+		return
+	}
+	expectJson, err := canonicalJSON([]byte(expect))
+	if err != nil {
+		str := fmt.Sprintf(`invalid JSON in expect value:
+ expect: (%T) %q
+  error: %v`, expect, show(t, expect), err)
+		fail(t, str+note, msg...)
+		return
+	}
+	if equal, ok := jsonEqual(t, gotJson, expectJson, cmpOptionsOf(t), msg...); ok && !equal {
+		str := fmt.Sprintf(`%s body JSON structures are not equal:
+    got: (%T) %q
+ expect: (%T) %q`, kind, got, show(t, got), expect, show(t, expect))
+		failValues(t, got, expect, str+note, msg...)
+	}
+}
+
+// RequestOption modifies a request before it is served by ServeHTTP.
+type RequestOption func(r *http.Request) error
+
+// WithHeader returns a RequestOption that sets the request header key to value.
+func WithHeader(key, value string) RequestOption {
+	return func(r *http.Request) error {
+		r.Header.Set(key, value)
+		return nil
+	}
+}
+
+// WithJSONBody returns a RequestOption that sets the request body to the
+// JSON encoding of v and the Content-Type header to "application/json".
+// Strings and byte slices are used as the body verbatim.
+func WithJSONBody(v interface{}) RequestOption {
+	return func(r *http.Request) error {
+		var b []byte
+		switch x := v.(type) {
+		case string:
+			b = []byte(x)
+		case []byte:
+			b = x
+		default:
+			var err error
+			if b, err = json.Marshal(v); err != nil {
+				return err
+			}
+		}
+		r.Body = io.NopCloser(bytes.NewReader(b))
+		r.ContentLength = int64(len(b))
+		r.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(b)), nil
+		}
+		r.Header.Set("Content-Type", "application/json")
+		return nil
+	}
+}
+
+// ServeHTTP applies opts to req, executes handler with a recorder of its
+// response, like an httptest.ResponseRecorder, and returns a
+// ResponseAssertion over the recorded response. A test failure is reported if req is nil or an
+// option cannot be applied; the returned assertion then has no response.
+func ServeHTTP(t internal.T, handler http.Handler, req *http.Request, opts ...RequestOption) *ResponseAssertion {
+	t.Helper()
+	if req == nil {
+		a := &ResponseAssertion{t: chain(t)}
+		fail(a.t, "expect not nil request")
+		return a
+	}
+	if req.Header == nil {
+		req.Header = make(http.Header)
+	}
+	for _, opt := range opts {
+		if err := opt(req); err != nil {
+			str := fmt.Sprintf(`unable to build request:
+ method: %s
+    url: %s
+  error: %v`, req.Method, req.URL, err)
+			a := &ResponseAssertion{t: chain(t)}
+			fail(a.t, str)
+			return a
+		}
+	}
+	rec := new(responseRecorder)
+	handler.ServeHTTP(rec, req)
+	return ThatResponse(t, rec)
+}
+
+// responseRecorder records the response of a handler for ServeHTTP, as an
+// httptest.ResponseRecorder does. It is implemented here so that this
+// package, which also serves non-test code through package check, does not
+// import net/http/httptest, which registers a command-line flag.
+type responseRecorder struct {
+	header      http.Header
+	sent        http.Header // header as of WriteHeader
+	code        int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+// Header implements http.ResponseWriter.
+func (r *responseRecorder) Header() http.Header {
+	if r.header == nil {
+		r.header = make(http.Header)
+	}
+	return r.header
+}
+
+// WriteHeader implements http.ResponseWriter.
+func (r *responseRecorder) WriteHeader(code int) {
+	if r.wroteHeader {
+		return
+	}
+	r.wroteHeader = true
+	r.code = code
+	r.sent = r.Header().Clone()
+}
+
+// Write implements http.ResponseWriter. As net/http does, the first write
+// sets the status code to 200 and sniffs the content type if not set.
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	if !r.wroteHeader {
+		h := r.Header()
+		if _, ok := h["Content-Type"]; !ok && h.Get("Transfer-Encoding") == "" {
+			h.Set("Content-Type", http.DetectContentType(b))
+		}
+		r.WriteHeader(http.StatusOK)
+	}
+	return r.body.Write(b)
+}
+
+// Flush implements http.Flusher.
+func (r *responseRecorder) Flush() {
+	r.WriteHeader(http.StatusOK)
+}
+
+// Result implements ResponseRecorder.
+func (r *responseRecorder) Result() *http.Response {
+	r.WriteHeader(http.StatusOK)
+	contentLength := int64(-1)
+	if n, err := strconv.ParseInt(r.sent.Get("Content-Length"), 10, 64); err == nil {
+		contentLength = n
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%03d %s", r.code, http.StatusText(r.code)),
+		StatusCode:    r.code,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        r.sent,
+		Body:          io.NopCloser(bytes.NewReader(r.body.Bytes())),
+		ContentLength: contentLength,
+	}
+}
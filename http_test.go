@@ -0,0 +1,227 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/internal/mock"
+)
+
+func userHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = io.WriteString(w, `{"id": 1, "name": "bob"}`)
+}
+
+func TestResponse_Recorder(t *testing.T) {
+	rec := httptest.NewRecorder()
+	userHandler(rec, httptest.NewRequest(http.MethodGet, "/user/1", nil))
+
+	runCase(t, func(g *mock.MockT) {
+		assert.ThatResponse(g, rec).
+			StatusIs(http.StatusOK).
+			HeaderEqual("content-type", "application/json").
+			BodyJSONEqual(`{"name": "bob", "id": 1}`).
+			BodyContains(`"bob"`).
+			Body().HasPrefix("{")
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`status code mismatch:
+    got: 200 OK
+ expect: 404 Not Found`})
+		g.EXPECT().Error([]interface{}{`header mismatch:
+ header: "Content-Type"
+    got: "application/json"
+ expect: "text/plain"`})
+		assert.ThatResponse(assert.New(g).WithContinueChains(true), rec).StatusIs(http.StatusNotFound).HeaderEqual("Content-Type", "text/plain")
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`response body JSON structures are not equal:
+    got: (string) "{\"id\": 1, \"name\": \"bob\"}"
+ expect: (string) "{\"id\": 2}"
+message: param (index=0)`})
+		assert.ThatResponse(g, rec).BodyJSONEqual(`{"id": 2}`, "param (index=0)")
+	})
+}
+
+func TestResponse_Client(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(userHandler))
+	defer server.Close()
+
+	resp, err := http.Get(server.URL)
+	assert.Nil(t, err)
+	runCase(t, func(g *mock.MockT) {
+		assert.ThatResponse(g, resp).StatusIs(http.StatusOK).BodyEqual(`{"id": 1, "name": "bob"}`)
+	})
+
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`response body does not contain the specified substring:
+    got: (string) "{\"id\": 1, \"name\": \"bob\"}"
+ expect: to contain substring "alice"`})
+		assert.ThatResponse(g, resp).BodyContains("alice")
+	})
+
+	// the body is restored and can still be read after the assertions
+	b, err := io.ReadAll(resp.Body)
+	assert.Nil(t, err)
+	assert.ThatString(t, string(b)).Equal(`{"id": 1, "name": "bob"}`)
+
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"expect not nil response"})
+		assert.ThatResponse(g, (*http.Response)(nil)).StatusIs(http.StatusOK)
+	})
+
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"unsupported response type (string)"})
+		assert.ThatResponse(g, "200 OK")
+	})
+}
+
+func TestServeHTTP(t *testing.T) {
+	echo := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", r.Header.Get("Content-Type"))
+		w.Header().Set("X-Request-Id", r.Header.Get("X-Request-Id"))
+		w.WriteHeader(http.StatusCreated)
+		_, _ = io.Copy(w, r.Body)
+	})
+
+	runCase(t, func(g *mock.MockT) {
+		assert.ServeHTTP(g, echo, httptest.NewRequest(http.MethodPost, "/users", nil),
+			assert.WithJSONBody(map[string]interface{}{"name": "bob"}),
+			assert.WithHeader("X-Request-Id", "42"),
+		).
+			StatusIs(http.StatusCreated).
+			HeaderEqual("Content-Type", "application/json").
+			HeaderEqual("X-Request-Id", "42").
+			BodyJSONEqual(`{"name": "bob"}`)
+	})
+	runCase(t, func(g *mock.MockT) {
+		assert.ServeHTTP(g, echo, httptest.NewRequest(http.MethodPost, "/users", nil),
+			assert.WithJSONBody(`{"raw": true}`),
+		).BodyEqual(`{"raw": true}`)
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`unable to build request:
+ method: POST
+    url: /users
+  error: json: unsupported type: chan int`})
+		assert.ServeHTTP(g, echo, httptest.NewRequest(http.MethodPost, "/users", nil),
+			assert.WithJSONBody(make(chan int)),
+		).StatusIs(http.StatusCreated)
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"expect not nil request"})
+		assert.ServeHTTP(g, echo, nil).StatusIs(http.StatusOK)
+	})
+}
+
+func TestResponse_Bounded(t *testing.T) {
+	const size = 1 << 20
+	big := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, strings.Repeat("a", size-1)+"b")
+	})
+	server := httptest.NewServer(big)
+	defer server.Close()
+
+	runCase(t, func(g *mock.MockT) {
+		resp, err := http.Get(server.URL)
+		assert.Nil(g, err)
+		assert.ThatResponse(g, resp).LimitedTo(4).
+			BodyEqual("aaaa" + strings.Repeat("x", 100)).
+			BodyContains("aa")
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`response body does not contain the specified substring:
+    got: (string) "aaaa"
+ expect: to contain substring "b"
+   note: body truncated to the first 4 bytes`})
+		resp, err := http.Get(server.URL)
+		assert.Nil(g, err)
+		assert.ThatResponse(g, resp).LimitedTo(4).BodyContains("b")
+	})
+	runCase(t, func(g *mock.MockT) {
+		resp, err := http.Get(server.URL)
+		assert.Nil(g, err)
+		assert.ThatResponse(g, resp).StatusIs(http.StatusOK).
+			BodyStream().ContentEqual(strings.Repeat("a", size-1) + "b")
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`stream content not equal:
+ offset: 1048575
+    got: ..."aaaaaaaaaaaaaaaab"
+ expect: ..."aaaaaaaaaaaaaaaac"`})
+		resp, err := http.Get(server.URL)
+		assert.Nil(g, err)
+		assert.ThatResponse(g, resp).BodyStream().ContentEqual(strings.Repeat("a", size-1) + "c")
+	})
+	runCase(t, func(g *mock.MockT) {
+		rec := httptest.NewRecorder()
+		big(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+		assert.ThatResponse(g, rec).LimitedTo(8).BodyStream().ContentEqual("aaaaaaaa")
+	})
+}
+
+func TestResponse_Gzip(t *testing.T) {
+	gz := gzipped(`{"id": 1}`)
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Encoding", "gzip")
+		_, _ = w.Write(gz)
+	}
+
+	runCase(t, func(g *mock.MockT) {
+		rec := httptest.NewRecorder()
+		handler(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+		resp := rec.Result()
+		assert.ThatResponse(g, resp).
+			HeaderEqual("Content-Encoding", "gzip").
+			BodyEqual(`{"id": 1}`).
+			BodyJSONEqual(`{"id": 1}`)
+		// the encoded body is restored
+		b, err := io.ReadAll(resp.Body)
+		assert.Nil(g, err)
+		assert.ThatBytes(g, b).Equal(gz)
+	})
+	runCase(t, func(g *mock.MockT) {
+		rec := httptest.NewRecorder()
+		handler(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+		assert.ThatResponse(g, rec).BodyStream().ContentEqual(`{"id": 1}`)
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{fmt.Sprintf(`response body does not contain the specified substring:
+    got: (string) "{\"id\": 1}"
+ expect: to contain substring "name"
+compressed: gzip, %d bytes`, len(gz))})
+		rec := httptest.NewRecorder()
+		handler(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+		assert.ThatResponse(g, rec).BodyContains("name")
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`unable to read response body:
+  error: gzip: invalid header`})
+		rec := httptest.NewRecorder()
+		rec.Header().Set("Content-Encoding", "gzip")
+		_, _ = io.WriteString(rec, "plain text")
+		assert.ThatResponse(g, rec).BodyEqual("plain text")
+	})
+}
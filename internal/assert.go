@@ -16,10 +16,17 @@
 
 package internal
 
-//go:generate mockgen -build_flags="-mod=mod" -package=internal -source=assert.go -destination=assert_mock.go
+//go:generate mockgen -build_flags="-mod=mod" -package=mock -source=assert.go -destination=mock/assert_mock.go
 
-// T is the minimum interface of *testing.T.
+// T is the subset of testing.TB used by assertions.
 type T interface {
 	Helper()
 	Error(args ...interface{})
+	Errorf(format string, args ...interface{})
+	Fatal(args ...interface{})
+	Fatalf(format string, args ...interface{})
+	Logf(format string, args ...interface{})
+	Name() string
+	Cleanup(fn func())
+	Skip(args ...interface{})
 }
@@ -0,0 +1,166 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/lvan100/go-assert/internal"
+)
+
+// canonicalJSON returns a canonical encoding of the JSON document b: object
+// keys are sorted, only the last of duplicate keys is kept, and numbers are
+// rewritten from their float64 value, as json.Unmarshal would decode them.
+// Two documents are equal as decoded by json.Unmarshal into interface{} if
+// and only if their canonical encodings are equal. The document is read
+// token by token, so that only the compact canonical encodings are held in
+// memory rather than trees of maps and slices.
+func canonicalJSON(b []byte) ([]byte, error) {
+	if json.Valid(b) {
+		dec := json.NewDecoder(bytes.NewReader(b))
+		dec.UseNumber()
+		var buf bytes.Buffer
+		if err := canonicalValue(dec, &buf); err == nil {
+			return buf.Bytes(), nil
+		}
+	}
+	// report the error json.Unmarshal reports, e.g. for numbers that do
+	// not fit a float64
+	var v interface{}
+	return nil, json.Unmarshal(b, &v)
+}
+
+// jsonEqual reports whether the canonical JSON documents got and expect are
+// equal. If go-cmp options are given, e.g. those set for t, see
+// Settings.CmpOptions, the decoded documents are compared with them; a
+// failure is then reported and ok is false if they cannot be decoded or
+// compared.
+func jsonEqual(t internal.T, got, expect []byte, opts []cmp.Option, msg ...interface{}) (equal, ok bool) {
+	t.Helper()
+	if len(opts) == 0 {
+		return bytes.Equal(got, expect), true
+	}
+	var gotV, expectV interface{}
+	err := json.Unmarshal(got, &gotV)
+	if err == nil {
+		err = json.Unmarshal(expect, &expectV)
+	}
+	if err != nil {
+		str := fmt.Sprintf(`unable to decode canonical JSON:
+    got: %s
+ expect: %s
+  error: %v`, show(t, string(got)), show(t, string(expect)), err)
+		fail(t, str, msg...)
+		return false, false
+	}
+	return equalWith(t, gotV, expectV, opts, msg...)
+}
+
+// canonicalValue writes the canonical encoding of the next value of dec.
+func canonicalValue(dec *json.Decoder, buf *bytes.Buffer) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	switch tok := tok.(type) {
+	case json.Delim:
+		if tok == '[' {
+			buf.WriteByte('[')
+			for i := 0; dec.More(); i++ {
+				if i > 0 {
+					buf.WriteByte(',')
+				}
+				if err = canonicalValue(dec, buf); err != nil {
+					return err
+				}
+			}
+			buf.WriteByte(']')
+		} else if err = canonicalObject(dec, buf); err != nil {
+			return err
+		}
+		_, err = dec.Token() // the closing delimiter
+		return err
+	case string:
+		writeString(buf, tok)
+	case json.Number:
+		f, err := tok.Float64()
+		if err != nil {
+			return err
+		}
+		if f == 0 {
+			f = 0 // -0 equals 0
+		}
+		buf.WriteString(strconv.FormatFloat(f, 'g', -1, 64))
+	case bool:
+		buf.WriteString(strconv.FormatBool(tok))
+	default:
+		buf.WriteString("null")
+	}
+	return nil
+}
+
+// canonicalObject writes the canonical encoding of the members of the
+// object whose opening delimiter dec has just read.
+func canonicalObject(dec *json.Decoder, buf *bytes.Buffer) error {
+	type member struct {
+		key   string
+		value []byte
+	}
+	var members []member
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		var value bytes.Buffer
+		if err = canonicalValue(dec, &value); err != nil {
+			return err
+		}
+		members = append(members, member{key: tok.(string), value: value.Bytes()})
+	}
+	slices.SortStableFunc(members, func(x, y member) int {
+		return strings.Compare(x.key, y.key)
+	})
+	buf.WriteByte('{')
+	first := true
+	for i, m := range members {
+		if i+1 < len(members) && members[i+1].key == m.key {
+			continue // a later duplicate overrides it
+		}
+		if !first {
+			buf.WriteByte(',')
+		}
+		first = false
+		writeString(buf, m.key)
+		buf.WriteByte(':')
+		buf.Write(m.value)
+	}
+	buf.WriteByte('}')
+	return nil
+}
+
+// writeString writes the JSON encoding of the string s.
+func writeString(buf *bytes.Buffer, s string) {
+	b, _ := json.Marshal(s) // strings always encode
+	buf.Write(b)
+}
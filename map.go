@@ -37,145 +37,176 @@ func ThatMap[K comparable, V comparable](t internal.T, v map[K]V) *MapAssertion[
 }
 
 // Len asserts that the map has the expected length.
-func (a *MapAssertion[K, V]) Len(length int, msg ...string) {
+func (a *MapAssertion[K, V]) Len(length int, msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	if len(a.v) != length {
 		str := fmt.Sprintf("got length %d but expect length %d", len(a.v), length)
 		fail(a.t, str, msg...)
+		return false
 	}
+	return true
 }
 
 // Empty asserts that the map is empty.
-func (a *MapAssertion[K, V]) Empty(msg ...string) {
+func (a *MapAssertion[K, V]) Empty(msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	if len(a.v) != 0 {
-		str := fmt.Sprintf("got %v is not empty", a.v)
+		str := fmt.Sprintf("got %v is not empty", show(a.t, a.v))
 		fail(a.t, str, msg...)
+		return false
 	}
+	return true
 }
 
 // NotEmpty asserts that the map is not empty.
-func (a *MapAssertion[K, V]) NotEmpty(msg ...string) {
+func (a *MapAssertion[K, V]) NotEmpty(msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	if len(a.v) == 0 {
-		str := fmt.Sprintf("got %v is empty", a.v)
+		str := fmt.Sprintf("got %v is empty", show(a.t, a.v))
 		fail(a.t, str, msg...)
+		return false
 	}
+	return true
 }
 
 // Equal asserts that the map is equal to the expected map.
-func (a *MapAssertion[K, V]) Equal(expect map[K]V, msg ...string) {
+func (a *MapAssertion[K, V]) Equal(expect map[K]V, msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	if len(a.v) != len(expect) {
 		str := fmt.Sprintf("got length %d but expect length %d", len(a.v), len(expect))
-		fail(a.t, str, msg...)
-		return
+		failValues(a.t, a.v, expect, str, msg...)
+		return false
 	}
-	for k, v := range a.v {
-		if expectV, ok := expect[k]; !ok || v != expectV {
-			str := fmt.Sprintf("got element %v at key %v but expect %v", v, k, expectV)
-			fail(a.t, str, msg...)
-			return
-		}
+	if k, v, ok := mapMismatch(a.v, expect, elementsEqual[V](a.t)); ok {
+		str := fmt.Sprintf("got element %v at key %v but expect %v", show(a.t, v), k, show(a.t, expect[k]))
+		failValues(a.t, a.v, expect, str, msg...)
+		return false
 	}
+	return true
 }
 
 // NotEqual asserts that the map is not equal to the expected map.
-func (a *MapAssertion[K, V]) NotEqual(expect map[K]V, msg ...string) {
+func (a *MapAssertion[K, V]) NotEqual(expect map[K]V, msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	if len(a.v) == len(expect) {
+		eq := elementsEqual[V](a.t)
 		equal := true
 		for k, v := range a.v {
-			if expectV, ok := expect[k]; !ok || v != expectV {
+			if expectV, ok := expect[k]; !ok || !eq(v, expectV) {
 				equal = false
 				break
 			}
 		}
 		if equal {
-			str := fmt.Sprintf("got %v but expect not %v", a.v, expect)
-			fail(a.t, str, msg...)
+			str := fmt.Sprintf("got %v but expect not %v", show(a.t, a.v), show(a.t, expect))
+			failValues(a.t, a.v, expect, str, msg...)
+			return false
 		}
 	}
+	return true
 }
 
 // Contains asserts that the map contains the expected key.
-func (a *MapAssertion[K, V]) Contains(key K, msg ...string) {
+func (a *MapAssertion[K, V]) Contains(key K, msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	if _, ok := a.v[key]; !ok {
-		str := fmt.Sprintf("got %v does not contain key %v", a.v, key)
+		str := fmt.Sprintf("got %v does not contain key %v", show(a.t, a.v), show(a.t, key))
 		fail(a.t, str, msg...)
+		return false
 	}
+	return true
 }
 
 // NotContains asserts that the map does not contain the expected key.
-func (a *MapAssertion[K, V]) NotContains(key K, msg ...string) {
+func (a *MapAssertion[K, V]) NotContains(key K, msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	if _, ok := a.v[key]; ok {
-		str := fmt.Sprintf("got %v contains key %v", a.v, key)
+		str := fmt.Sprintf("got %v contains key %v", show(a.t, a.v), show(a.t, key))
 		fail(a.t, str, msg...)
+		return false
 	}
+	return true
 }
 
 // ContainsValue asserts that the map contains the expected value.
-func (a *MapAssertion[K, V]) ContainsValue(value V, msg ...string) {
+func (a *MapAssertion[K, V]) ContainsValue(value V, msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	for _, v := range a.v {
 		if v == value {
-			return
+			return true
 		}
 	}
-	str := fmt.Sprintf("got %v does not contain value %v", a.v, value)
+	str := fmt.Sprintf("got %v does not contain value %v", show(a.t, a.v), show(a.t, value))
 	fail(a.t, str, msg...)
+	return false
 }
 
 // NotContainsValue asserts that the map does not contain the expected value.
-func (a *MapAssertion[K, V]) NotContainsValue(value V, msg ...string) {
+func (a *MapAssertion[K, V]) NotContainsValue(value V, msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	for _, v := range a.v {
 		if v == value {
-			str := fmt.Sprintf("got %v contains value %v", a.v, value)
+			str := fmt.Sprintf("got %v contains value %v", show(a.t, a.v), show(a.t, value))
 			fail(a.t, str, msg...)
-			return
+			return false
 		}
 	}
+	return true
 }
 
 // HasKeyValue asserts that the map contains the expected key-value pair.
-func (a *MapAssertion[K, V]) HasKeyValue(key K, value V, msg ...string) {
+func (a *MapAssertion[K, V]) HasKeyValue(key K, value V, msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	if v, ok := a.v[key]; !ok || v != value {
-		str := fmt.Sprintf("got %v does not contain key-value pair %v:%v", a.v, key, value)
+		str := fmt.Sprintf("got %v does not contain key-value pair %v:%v", show(a.t, a.v), show(a.t, key), show(a.t, value))
 		fail(a.t, str, msg...)
+		return false
 	}
+	return true
 }
 
 // ContainsKeys asserts that the map contains all the expected keys.
-func (a *MapAssertion[K, V]) ContainsKeys(keys []K, msg ...string) {
+func (a *MapAssertion[K, V]) ContainsKeys(keys []K, msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	for _, key := range keys {
 		if _, ok := a.v[key]; !ok {
-			str := fmt.Sprintf("got %v does not contain key %v", a.v, key)
+			str := fmt.Sprintf("got %v does not contain key %v", show(a.t, a.v), show(a.t, key))
 			fail(a.t, str, msg...)
-			return
+			return false
 		}
 	}
+	return true
 }
 
 // NotContainsKeys asserts that the map does not contain any of the expected keys.
-func (a *MapAssertion[K, V]) NotContainsKeys(keys []K, msg ...string) {
+func (a *MapAssertion[K, V]) NotContainsKeys(keys []K, msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	for _, key := range keys {
 		if _, ok := a.v[key]; ok {
-			str := fmt.Sprintf("got %v contains key %v", a.v, key)
+			str := fmt.Sprintf("got %v contains key %v", show(a.t, a.v), show(a.t, key))
 			fail(a.t, str, msg...)
-			return
+			return false
 		}
 	}
+	return true
 }
 
 // ContainsValues asserts that the map contains all the expected values.
-func (a *MapAssertion[K, V]) ContainsValues(values []V, msg ...string) {
+func (a *MapAssertion[K, V]) ContainsValues(values []V, msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	for _, value := range values {
 		found := false
 		for _, v := range a.v {
@@ -185,88 +216,128 @@ func (a *MapAssertion[K, V]) ContainsValues(values []V, msg ...string) {
 			}
 		}
 		if !found {
-			str := fmt.Sprintf("got %v does not contain value %v", a.v, value)
+			str := fmt.Sprintf("got %v does not contain value %v", show(a.t, a.v), show(a.t, value))
 			fail(a.t, str, msg...)
-			return
+			return false
 		}
 	}
+	return true
 }
 
 // NotContainsValues asserts that the map does not contain any of the expected values.
-func (a *MapAssertion[K, V]) NotContainsValues(values []V, msg ...string) {
+func (a *MapAssertion[K, V]) NotContainsValues(values []V, msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	for _, value := range values {
 		for _, v := range a.v {
 			if v == value {
-				str := fmt.Sprintf("got %v contains value %v", a.v, value)
+				str := fmt.Sprintf("got %v contains value %v", show(a.t, a.v), show(a.t, value))
 				fail(a.t, str, msg...)
-				return
+				return false
 			}
 		}
 	}
+	return true
 }
 
 // IsSubsetOf asserts that the map is a subset of the expected map.
-func (a *MapAssertion[K, V]) IsSubsetOf(expect map[K]V, msg ...string) {
+func (a *MapAssertion[K, V]) IsSubsetOf(expect map[K]V, msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	for k, v := range a.v {
 		if expectV, ok := expect[k]; !ok || v != expectV {
-			str := fmt.Sprintf("got %v is not a subset of %v", a.v, expect)
-			fail(a.t, str, msg...)
-			return
+			str := fmt.Sprintf("got %v is not a subset of %v", show(a.t, a.v), show(a.t, expect))
+			failValues(a.t, a.v, expect, str, msg...)
+			return false
 		}
 	}
+	return true
 }
 
 // IsSupersetOf asserts that the map is a superset of the expected map.
-func (a *MapAssertion[K, V]) IsSupersetOf(expect map[K]V, msg ...string) {
+func (a *MapAssertion[K, V]) IsSupersetOf(expect map[K]V, msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	for k, v := range expect {
 		if aV, ok := a.v[k]; !ok || aV != v {
-			str := fmt.Sprintf("got %v is not a superset of %v", a.v, expect)
-			fail(a.t, str, msg...)
-			return
+			str := fmt.Sprintf("got %v is not a superset of %v", show(a.t, a.v), show(a.t, expect))
+			failValues(a.t, a.v, expect, str, msg...)
+			return false
 		}
 	}
+	return true
 }
 
 // HasSameKeys asserts that the map has the same keys as the expected map.
-func (a *MapAssertion[K, V]) HasSameKeys(expect map[K]V, msg ...string) {
+func (a *MapAssertion[K, V]) HasSameKeys(expect map[K]V, msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	if len(a.v) != len(expect) {
-		str := fmt.Sprintf("got %v does not have the same keys as %v", a.v, expect)
-		fail(a.t, str, msg...)
-		return
+		str := fmt.Sprintf("got %v does not have the same keys as %v", show(a.t, a.v), show(a.t, expect))
+		failValues(a.t, a.v, expect, str, msg...)
+		return false
 	}
 	for k := range a.v {
 		if _, ok := expect[k]; !ok {
-			str := fmt.Sprintf("got %v does not have the same keys as %v", a.v, expect)
-			fail(a.t, str, msg...)
-			return
+			str := fmt.Sprintf("got %v does not have the same keys as %v", show(a.t, a.v), show(a.t, expect))
+			failValues(a.t, a.v, expect, str, msg...)
+			return false
 		}
 	}
+	return true
 }
 
 // HasSameValues asserts that the map has the same values as the expected map.
-func (a *MapAssertion[K, V]) HasSameValues(expect map[K]V, msg ...string) {
+func (a *MapAssertion[K, V]) HasSameValues(expect map[K]V, msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	if len(a.v) != len(expect) {
-		str := fmt.Sprintf("got %v does not have the same values as %v", a.v, expect)
-		fail(a.t, str, msg...)
-		return
-	}
-	valueCount := make(map[V]int)
-	for _, v := range a.v {
-		valueCount[v]++
+		str := fmt.Sprintf("got %v does not have the same values as %v", show(a.t, a.v), show(a.t, expect))
+		failValues(a.t, a.v, expect, str, msg...)
+		return false
 	}
-	for _, v := range expect {
-		valueCount[v]--
-	}
-	for _, count := range valueCount {
+	for _, count := range countValues(a.v, expect) {
 		if count != 0 {
-			str := fmt.Sprintf("got %v does not have the same values as %v", a.v, expect)
-			fail(a.t, str, msg...)
-			return
+			str := fmt.Sprintf("got %v does not have the same values as %v", show(a.t, a.v), show(a.t, expect))
+			failValues(a.t, a.v, expect, str, msg...)
+			return false
+		}
+	}
+	return true
+}
+
+// mapMismatch returns a key of got, and its value, that differs from the
+// one in expect as compared by eq, or false if all keys of got have the
+// same values in expect. Among several differing keys, the one printed
+// first in sort order is returned, so that failure messages do not depend
+// on the map iteration order.
+func mapMismatch[K, V comparable](got, expect map[K]V, eq func(x, y V) bool) (K, V, bool) {
+	var (
+		key   K
+		value V
+		str   string
+		found bool
+	)
+	for k, v := range got {
+		if e, ok := expect[k]; ok && eq(v, e) {
+			continue
 		}
+		if s := fmt.Sprint(k); !found || s < str {
+			key, value, str, found = k, v, s, true
+		}
+	}
+	return key, value, found
+}
+
+// countValues returns the number of occurrences of each value in got minus
+// that in expect.
+func countValues[K, V comparable](got, expect map[K]V) map[V]int {
+	counts := make(map[V]int)
+	for _, v := range got {
+		counts[v]++
+	}
+	for _, v := range expect {
+		counts[v]--
 	}
+	return counts
 }
@@ -15,3 +15,35 @@
  */
 
 package assert_test
+
+import (
+	"testing"
+
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/internal/mock"
+)
+
+func TestMap_Equal(t *testing.T) {
+	runCase(t, func(g *mock.MockT) {
+		assert.ThatMap(g, map[string]int{"a": 1, "b": 2}).Equal(map[string]int{"b": 2, "a": 1})
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"got length 1 but expect length 2"})
+		assert.ThatMap(g, map[string]int{"a": 1}).Equal(map[string]int{"a": 1, "b": 2})
+	})
+	// the first differing key in sort order is reported
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"got element 0 at key b but expect 2"})
+		assert.ThatMap(g, map[string]int{"a": 1, "b": 0, "c": 0, "d": 0}).Equal(map[string]int{"a": 1, "b": 2, "c": 3, "d": 4})
+	})
+}
+
+func TestMap_HasSameValues(t *testing.T) {
+	runCase(t, func(g *mock.MockT) {
+		assert.ThatMap(g, map[string]int{"a": 1, "b": 2}).HasSameValues(map[string]int{"x": 2, "y": 1})
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"got map[a:1 b:1] does not have the same values as map[x:1 y:2]"})
+		assert.ThatMap(g, map[string]int{"a": 1, "b": 1}).HasSameValues(map[string]int{"x": 1, "y": 2})
+	})
+}
@@ -0,0 +1,121 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/lvan100/go-assert/internal"
+)
+
+// Matcher is a domain-specific check of a value, which can be used with
+// Match and ThatAssertion.Match. Match reports whether v matches and, if
+// not, a message describing why.
+type Matcher interface {
+	Match(v interface{}) (ok bool, failureMsg string)
+}
+
+// MatcherFunc adapts a function to a Matcher.
+type MatcherFunc func(v interface{}) (ok bool, failureMsg string)
+
+// Match implements Matcher.
+func (f MatcherFunc) Match(v interface{}) (bool, string) {
+	return f(v)
+}
+
+// AllOf returns a Matcher matching the values all of ms match. Its failure
+// message lists every matcher that failed, numbered from 1 in the order of ms.
+func AllOf(ms ...Matcher) Matcher {
+	return MatcherFunc(func(v interface{}) (bool, string) {
+		var failures []string
+		for i, m := range ms {
+			if ok, failureMsg := m.Match(v); !ok {
+				failures = append(failures, branchFailure(i, failureMsg))
+			}
+		}
+		if len(failures) > 0 {
+			return false, fmt.Sprintf("%d of %d matchers failed: %s", len(failures), len(ms), strings.Join(failures, "; "))
+		}
+		return true, ""
+	})
+}
+
+// AnyOf returns a Matcher matching the values at least one of ms matches.
+// Its failure message lists why each matcher failed.
+func AnyOf(ms ...Matcher) Matcher {
+	return MatcherFunc(func(v interface{}) (bool, string) {
+		failures := make([]string, 0, len(ms))
+		for i, m := range ms {
+			ok, failureMsg := m.Match(v)
+			if ok {
+				return true, ""
+			}
+			failures = append(failures, branchFailure(i, failureMsg))
+		}
+		return false, fmt.Sprintf("none of %d matchers matched: %s", len(ms), strings.Join(failures, "; "))
+	})
+}
+
+// Not returns a Matcher matching the values m does not match.
+func Not(m Matcher) Matcher {
+	return MatcherFunc(func(v interface{}) (bool, string) {
+		if ok, _ := m.Match(v); ok {
+			return false, "matched but expect not to match"
+		}
+		return true, ""
+	})
+}
+
+// branchFailure describes the failure of the i-th matcher of a combinator.
+func branchFailure(i int, failureMsg string) string {
+	if failureMsg == "" {
+		failureMsg = "no match"
+	}
+	return fmt.Sprintf("#%d %s", i+1, failureMsg)
+}
+
+// Match asserts that v matches m. It reports an error with the failure
+// message of m otherwise.
+func Match(t internal.T, v interface{}, m Matcher, msg ...interface{}) bool {
+	t.Helper()
+	defer track(t, v)()
+	return matchValue(t, v, m, msg...)
+}
+
+// Match asserts that the value matches m, see Match.
+func (a *ThatAssertion) Match(m Matcher, msg ...interface{}) (ok bool) {
+	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
+	return matchValue(a.t, a.v, m, msg...)
+}
+
+// matchValue implements Match.
+func matchValue(t internal.T, v interface{}, m Matcher, msg ...interface{}) bool {
+	t.Helper()
+	ok, failureMsg := m.Match(v)
+	if ok {
+		return true
+	}
+	str := fmt.Sprintf(`value does not match:
+    got: (%T) %v`, v, show(t, v))
+	if failureMsg != "" {
+		str += "\n reason: " + failureMsg
+	}
+	fail(t, str, msg...)
+	return false
+}
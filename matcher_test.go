@@ -0,0 +1,85 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/internal/mock"
+	"go.uber.org/mock/gomock"
+)
+
+// isUpper is a Matcher matching upper case strings.
+var isUpper = assert.MatcherFunc(func(v interface{}) (bool, string) {
+	s, ok := v.(string)
+	if !ok {
+		return false, "not a string"
+	}
+	if s != strings.ToUpper(s) {
+		return false, "not upper case"
+	}
+	return true, ""
+})
+
+func TestMatch(t *testing.T) {
+	runCase(t, func(g *mock.MockT) {
+		assert.Match(g, "ABC", isUpper)
+		assert.That(g, "ABC").Match(isUpper)
+		assert.That(g, "abc").Not().Match(isUpper)
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error(gomock.Any()).Do(func(args ...interface{}) {
+			assert.ThatString(t, args[0].(string)).Equal("value does not match:\n    got: (string) abc\n reason: not upper case\nmessage: name")
+		})
+		assert.Match(g, "abc", isUpper, "name")
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error(gomock.Any()).Do(func(args ...interface{}) {
+			assert.ThatString(t, args[0].(string)).Equal("value does not match:\n    got: (int) 1\n reason: not a string")
+		})
+		assert.That(g, 1).Match(isUpper)
+	})
+}
+
+func TestMatcherCombinators(t *testing.T) {
+	short := assert.MatcherFunc(func(v interface{}) (bool, string) {
+		if len(v.(string)) > 3 {
+			return false, "too long"
+		}
+		return true, ""
+	})
+	runCase(t, func(g *mock.MockT) {
+		assert.Match(g, "ABC", assert.AllOf(isUpper, short))
+		assert.Match(g, "abc", assert.AnyOf(isUpper, short))
+		assert.Match(g, "abc", assert.Not(isUpper))
+		assert.Match(g, "ABCD", assert.AllOf(isUpper, assert.Not(short)))
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"value does not match:\n    got: (string) abcd\n reason: 2 of 2 matchers failed: #1 not upper case; #2 too long"})
+		assert.Match(g, "abcd", assert.AllOf(isUpper, short))
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"value does not match:\n    got: (string) abcd\n reason: none of 2 matchers matched: #1 not upper case; #2 too long"})
+		assert.Match(g, "abcd", assert.AnyOf(isUpper, short))
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"value does not match:\n    got: (string) ABC\n reason: 1 of 2 matchers failed: #2 matched but expect not to match"})
+		assert.Match(g, "ABC", assert.AllOf(isUpper, assert.Not(short)))
+	})
+}
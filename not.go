@@ -0,0 +1,219 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"fmt"
+
+	"github.com/lvan100/go-assert/internal"
+)
+
+// negatedT is the test handler of an assertion returned by a Not method. It
+// inverts the next check made through it: the failures of the check are
+// swallowed, and if there are none the check is reported as failed. Later
+// checks go through unchanged.
+type negatedT struct {
+	t       internal.T
+	active  bool // the next check is still to be inverted
+	running bool // the inverted check is running
+	failed  bool // the inverted check failed
+	misused bool // the inverted check could not be made, see failUsage
+}
+
+// negate returns a test handler inverting the next check made through it.
+// Negating it again cancels the inversion.
+func negate(t internal.T) internal.T {
+	if n, ok := t.(*negatedT); ok && n.active {
+		return n.t
+	}
+	return &negatedT{t: t, active: true}
+}
+
+// swallows reports whether failures reported through t are swallowed by an
+// inverted check.
+func swallows(t internal.T) bool {
+	n, ok := t.(*negatedT)
+	return ok && n.active
+}
+
+// begin starts the inverted check, reporting false if there is none to
+// start, e.g. for assertions called by the inverted check.
+func (n *negatedT) begin() bool {
+	if !n.active || n.running {
+		return false
+	}
+	n.running = true
+	return true
+}
+
+// end ends the inverted check, reporting a failure if it passed.
+func (n *negatedT) end(v interface{}) {
+	n.t.Helper()
+	n.active, n.running = false, false
+	if n.failed || n.misused {
+		return
+	}
+	str := fmt.Sprintf("expect %s to fail", callerOp())
+	switch v.(type) {
+	case nil:
+	case string:
+		str += fmt.Sprintf(":\n    got: (%T) %q", v, show(n.t, v))
+	default:
+		str += fmt.Sprintf(":\n    got: (%T) %v", v, show(n.t, v))
+	}
+	fail(n.t, str)
+}
+
+// failUsage is like fail for checks that cannot be made at all, e.g. on
+// values of an unsupported type: their failures are reported even if the
+// check is inverted.
+func failUsage(t internal.T, str string, msg ...interface{}) {
+	t.Helper()
+	if n, ok := t.(*negatedT); ok && n.active {
+		n.misused = true
+		t = n.t
+	}
+	fail(t, str, msg...)
+}
+
+func (n *negatedT) Helper() {
+	n.t.Helper()
+}
+
+func (n *negatedT) Error(args ...interface{}) {
+	if n.active {
+		n.failed = true
+		return
+	}
+	n.t.Helper()
+	n.t.Error(args...)
+}
+
+func (n *negatedT) Errorf(format string, args ...interface{}) {
+	if n.active {
+		n.failed = true
+		return
+	}
+	n.t.Helper()
+	n.t.Errorf(format, args...)
+}
+
+func (n *negatedT) Fatal(args ...interface{}) {
+	if n.active {
+		n.failed = true
+		return
+	}
+	n.t.Helper()
+	n.t.Fatal(args...)
+}
+
+func (n *negatedT) Fatalf(format string, args ...interface{}) {
+	if n.active {
+		n.failed = true
+		return
+	}
+	n.t.Helper()
+	n.t.Fatalf(format, args...)
+}
+
+func (n *negatedT) Logf(format string, args ...interface{}) {
+	n.t.Helper()
+	n.t.Logf(format, args...)
+}
+
+func (n *negatedT) Name() string {
+	return n.t.Name()
+}
+
+func (n *negatedT) Cleanup(fn func()) {
+	n.t.Cleanup(fn)
+}
+
+func (n *negatedT) Skip(args ...interface{}) {
+	n.t.Helper()
+	n.t.Skip(args...)
+}
+
+// Unwrap returns the wrapped test handler.
+func (n *negatedT) Unwrap() internal.T {
+	return n.t
+}
+
+// Not returns a ThatAssertion over the same value whose next check is
+// inverted: it fails if the check passes and passes if the check fails,
+// and returns whether the inverted check passed.
+func (a *ThatAssertion) Not() *ThatAssertion {
+	c := *a
+	c.t = negate(a.t)
+	return &c
+}
+
+// Not returns a TypedAssertion over the same value whose next check is
+// inverted.
+func (a *TypedAssertion[T]) Not() *TypedAssertion[T] {
+	c := *a
+	c.t = negate(a.t)
+	return &c
+}
+
+// Not returns a StringAssertion over the same value whose next check is
+// inverted, e.g. ThatString(t, s).Not().Contains("secret").
+func (a *StringAssertion) Not() *StringAssertion {
+	c := *a
+	c.t = negate(a.t)
+	return &c
+}
+
+// Not returns a NumberAssertion over the same value whose next check is
+// inverted.
+func (a *NumberAssertion[T]) Not() *NumberAssertion[T] {
+	c := *a
+	c.t = negate(a.t)
+	return &c
+}
+
+// Not returns an OrderedAssertion over the same value whose next check is
+// inverted.
+func (a *OrderedAssertion[T]) Not() *OrderedAssertion[T] {
+	c := *a
+	c.t = negate(a.t)
+	return &c
+}
+
+// Not returns a SliceAssertion over the same value whose next check is
+// inverted.
+func (a *SliceAssertion[T]) Not() *SliceAssertion[T] {
+	c := *a
+	c.t = negate(a.t)
+	return &c
+}
+
+// Not returns a MapAssertion over the same value whose next check is
+// inverted.
+func (a *MapAssertion[K, V]) Not() *MapAssertion[K, V] {
+	c := *a
+	c.t = negate(a.t)
+	return &c
+}
+
+// Not returns an ErrorAssertion over the same error whose next check is
+// inverted.
+func (a *ErrorAssertion) Not() *ErrorAssertion {
+	c := *a
+	c.t = negate(a.t)
+	return &c
+}
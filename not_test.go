@@ -0,0 +1,97 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/internal/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestNot(t *testing.T) {
+	runCase(t, func(g *mock.MockT) {
+		assert.ThatString(g, "my password").Not().Contains("secret")
+		assert.That(g, 1).Not().Equal(2)
+		assert.ThatNumber(g, 3).Not().LessThan(2)
+		assert.ThatSlice(g, []int{1, 2}).Not().Contains(3)
+		assert.ThatMap(g, map[string]int{"a": 1}).Not().Contains("b")
+		assert.ThatError(g, errors.New("boom")).Not().IsNil()
+		assert.ThatString(g, "a").Not().Not().Equal("a")
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error(gomock.Any()).Do(func(args ...interface{}) {
+			assert.ThatString(t, args[0].(string)).Equal("expect StringAssertion.Contains to fail:\n    got: (string) \"my secret\"")
+		})
+		assert.ThatString(g, "my secret").Not().Contains("secret")
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error(gomock.Any()).Do(func(args ...interface{}) {
+			assert.ThatString(t, args[0].(string)).Equal("strings not equal:\n    got: (string) \"a\"\n expect: (string) \"b\"")
+		})
+		// only the next check is inverted
+		assert.ThatString(g, "a").Not().HasPrefix("b").Equal("b")
+	})
+}
+
+func TestNot_Result(t *testing.T) {
+	runCase(t, func(g *mock.MockT) {
+		assert.ThatBool(t, assert.That(g, 1).Not().Equal(2)).IsTrue()
+		assert.ThatBool(t, assert.ThatNumber(g, 3).Not().LessThan(2)).IsTrue()
+		assert.ThatBool(t, assert.That(g, 1).Not().Not().Equal(1)).IsTrue()
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"expect ThatAssertion.Equal to fail:\n    got: (int) 1"})
+		assert.ThatBool(t, assert.That(g, 1).Not().Equal(1)).IsFalse()
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error(gomock.Any())
+		assert.ThatBool(t, assert.ThatSlice(g, []int{1, 2}).Not().Contains(1)).IsFalse()
+	})
+	runCase(t, func(g *mock.MockT) {
+		// only the next check is inverted
+		a := assert.That(g, 1).Not()
+		assert.ThatBool(t, a.Equal(2)).IsTrue()
+		assert.ThatBool(t, a.Equal(1)).IsTrue()
+	})
+}
+
+func TestNot_Usage(t *testing.T) {
+	// checks that cannot be made fail even if inverted
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"unsupported value (int) 1"})
+		assert.ThatBool(t, assert.That(g, 1).Not().IsEmpty()).IsFalse()
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"unsupported value (int) 1"})
+		assert.ThatBool(t, assert.That(g, 1).Not().HasLen(1)).IsFalse()
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"unsupported expect value (int) 2"})
+		assert.ThatBool(t, assert.That(g, 1).Not().InSlice(2)).IsFalse()
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"unsupported expect value (string) a"})
+		assert.ThatBool(t, assert.That(g, 1).Not().InMapKeys("a")).IsFalse()
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"method 'Contains' not found on type int"})
+		assert.ThatBool(t, assert.That(g, 1).Not().Contains(1)).IsFalse()
+	})
+}
@@ -41,169 +41,223 @@ func ThatNumber[T Number](t internal.T, v T) *NumberAssertion[T] {
 }
 
 // Equal asserts that the number value is equal to the expected value.
-func (a *NumberAssertion[T]) Equal(expect T, msg ...string) {
+func (a *NumberAssertion[T]) Equal(expect T, msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	if a.v != expect {
-		str := fmt.Sprintf("got (%T) %v but expect (%T) %v", a.v, a.v, expect, expect)
-		fail(a.t, str, msg...)
+		str := fmt.Sprintf("got (%T) %v but expect (%T) %v", a.v, show(a.t, a.v), expect, show(a.t, expect))
+		failValues(a.t, a.v, expect, str, msg...)
+		return false
 	}
+	return true
 }
 
 // NotEqual asserts that the number value is not equal to the expected value.
-func (a *NumberAssertion[T]) NotEqual(expect T, msg ...string) {
+func (a *NumberAssertion[T]) NotEqual(expect T, msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	if a.v == expect {
-		str := fmt.Sprintf("got (%T) %v but expect not (%T) %v", a.v, a.v, expect, expect)
-		fail(a.t, str, msg...)
+		str := fmt.Sprintf("got (%T) %v but expect not (%T) %v", a.v, show(a.t, a.v), expect, show(a.t, expect))
+		failValues(a.t, a.v, expect, str, msg...)
+		return false
 	}
+	return true
 }
 
 // GreaterThan asserts that the number value is greater than the expected value.
-func (a *NumberAssertion[T]) GreaterThan(expect T, msg ...string) {
+func (a *NumberAssertion[T]) GreaterThan(expect T, msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	if a.v <= expect {
-		str := fmt.Sprintf("got (%T) %v but expect greater than (%T) %v", a.v, a.v, expect, expect)
-		fail(a.t, str, msg...)
+		str := fmt.Sprintf("got (%T) %v but expect greater than (%T) %v", a.v, show(a.t, a.v), expect, show(a.t, expect))
+		failValues(a.t, a.v, expect, str, msg...)
+		return false
 	}
+	return true
 }
 
 // GreaterOrEqual asserts that the number value is greater than or equal to the expected value.
-func (a *NumberAssertion[T]) GreaterOrEqual(expect T, msg ...string) {
+func (a *NumberAssertion[T]) GreaterOrEqual(expect T, msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	if a.v < expect {
-		str := fmt.Sprintf("got (%T) %v but expect greater than or equal to (%T) %v", a.v, a.v, expect, expect)
-		fail(a.t, str, msg...)
+		str := fmt.Sprintf("got (%T) %v but expect greater than or equal to (%T) %v", a.v, show(a.t, a.v), expect, show(a.t, expect))
+		failValues(a.t, a.v, expect, str, msg...)
+		return false
 	}
+	return true
 }
 
 // LessThan asserts that the number value is less than the expected value.
-func (a *NumberAssertion[T]) LessThan(expect T, msg ...string) {
+func (a *NumberAssertion[T]) LessThan(expect T, msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	if a.v >= expect {
-		str := fmt.Sprintf("got (%T) %v but expect less than (%T) %v", a.v, a.v, expect, expect)
-		fail(a.t, str, msg...)
+		str := fmt.Sprintf("got (%T) %v but expect less than (%T) %v", a.v, show(a.t, a.v), expect, show(a.t, expect))
+		failValues(a.t, a.v, expect, str, msg...)
+		return false
 	}
+	return true
 }
 
 // LessOrEqual asserts that the number value is less than or equal to the expected value.
-func (a *NumberAssertion[T]) LessOrEqual(expect T, msg ...string) {
+func (a *NumberAssertion[T]) LessOrEqual(expect T, msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	if a.v > expect {
-		str := fmt.Sprintf("got (%T) %v but expect less than or equal to (%T) %v", a.v, a.v, expect, expect)
-		fail(a.t, str, msg...)
+		str := fmt.Sprintf("got (%T) %v but expect less than or equal to (%T) %v", a.v, show(a.t, a.v), expect, show(a.t, expect))
+		failValues(a.t, a.v, expect, str, msg...)
+		return false
 	}
+	return true
 }
 
 // IsZero asserts that the number value is zero.
-func (a *NumberAssertion[T]) IsZero(msg ...string) {
+func (a *NumberAssertion[T]) IsZero(msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	if a.v != 0 {
-		str := fmt.Sprintf("got (%T) %v but expect zero", a.v, a.v)
+		str := fmt.Sprintf("got (%T) %v but expect zero", a.v, show(a.t, a.v))
 		fail(a.t, str, msg...)
+		return false
 	}
+	return true
 }
 
 // NotZero asserts that the number value is not zero.
-func (a *NumberAssertion[T]) NotZero(msg ...string) {
+func (a *NumberAssertion[T]) NotZero(msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	if a.v == 0 {
-		str := fmt.Sprintf("got (%T) %v but expect not zero", a.v, a.v)
+		str := fmt.Sprintf("got (%T) %v but expect not zero", a.v, show(a.t, a.v))
 		fail(a.t, str, msg...)
+		return false
 	}
+	return true
 }
 
 // IsPositive asserts that the number value is positive.
-func (a *NumberAssertion[T]) IsPositive(msg ...string) {
+func (a *NumberAssertion[T]) IsPositive(msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	if a.v <= 0 {
-		str := fmt.Sprintf("got (%T) %v but expect positive", a.v, a.v)
+		str := fmt.Sprintf("got (%T) %v but expect positive", a.v, show(a.t, a.v))
 		fail(a.t, str, msg...)
+		return false
 	}
+	return true
 }
 
 // IsNegative asserts that the number value is negative.
-func (a *NumberAssertion[T]) IsNegative(msg ...string) {
+func (a *NumberAssertion[T]) IsNegative(msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	if a.v >= 0 {
-		str := fmt.Sprintf("got (%T) %v but expect negative", a.v, a.v)
+		str := fmt.Sprintf("got (%T) %v but expect negative", a.v, show(a.t, a.v))
 		fail(a.t, str, msg...)
+		return false
 	}
+	return true
 }
 
 // IsNonNegative asserts that the number value is non-negative.
-func (a *NumberAssertion[T]) IsNonNegative(msg ...string) {
+func (a *NumberAssertion[T]) IsNonNegative(msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	if a.v < 0 {
-		str := fmt.Sprintf("got (%T) %v but expect non-negative", a.v, a.v)
+		str := fmt.Sprintf("got (%T) %v but expect non-negative", a.v, show(a.t, a.v))
 		fail(a.t, str, msg...)
+		return false
 	}
+	return true
 }
 
 // IsNonPositive asserts that the number value is non-positive.
-func (a *NumberAssertion[T]) IsNonPositive(msg ...string) {
+func (a *NumberAssertion[T]) IsNonPositive(msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	if a.v > 0 {
-		str := fmt.Sprintf("got (%T) %v but expect non-positive", a.v, a.v)
+		str := fmt.Sprintf("got (%T) %v but expect non-positive", a.v, show(a.t, a.v))
 		fail(a.t, str, msg...)
+		return false
 	}
+	return true
 }
 
 // Between asserts that the number value is between the lower and upper bounds (inclusive).
-func (a *NumberAssertion[T]) Between(lower, upper T, msg ...string) {
+func (a *NumberAssertion[T]) Between(lower, upper T, msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	if a.v < lower || a.v > upper {
-		str := fmt.Sprintf("got (%T) %v but expect between (%T) %v and (%T) %v", a.v, a.v, lower, lower, upper, upper)
-		fail(a.t, str, msg...)
+		str := fmt.Sprintf("got (%T) %v but expect between (%T) %v and (%T) %v", a.v, show(a.t, a.v), lower, show(a.t, lower), upper, show(a.t, upper))
+		failValues(a.t, a.v, [2]T{lower, upper}, str, msg...)
+		return false
 	}
+	return true
 }
 
 // NotBetween asserts that the number value is not between the lower and upper bounds (exclusive).
-func (a *NumberAssertion[T]) NotBetween(lower, upper T, msg ...string) {
+func (a *NumberAssertion[T]) NotBetween(lower, upper T, msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	if a.v >= lower && a.v <= upper {
-		str := fmt.Sprintf("got (%T) %v but expect not between (%T) %v and (%T) %v", a.v, a.v, lower, lower, upper, upper)
-		fail(a.t, str, msg...)
+		str := fmt.Sprintf("got (%T) %v but expect not between (%T) %v and (%T) %v", a.v, show(a.t, a.v), lower, show(a.t, lower), upper, show(a.t, upper))
+		failValues(a.t, a.v, [2]T{lower, upper}, str, msg...)
+		return false
 	}
+	return true
 }
 
 // InDelta asserts that the number value is within the delta range of the expected value.
-func (a *NumberAssertion[T]) InDelta(expect T, delta T, msg ...string) {
+func (a *NumberAssertion[T]) InDelta(expect T, delta T, msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	diff := a.v - expect
 	if diff < 0 {
 		diff = -diff
 	}
 	if diff > delta {
-		str := fmt.Sprintf("got (%T) %v is not within delta (%T) %v of (%T) %v", a.v, a.v, delta, delta, expect, expect)
-		fail(a.t, str, msg...)
+		str := fmt.Sprintf("got (%T) %v is not within delta (%T) %v of (%T) %v", a.v, show(a.t, a.v), delta, show(a.t, delta), expect, show(a.t, expect))
+		failValues(a.t, a.v, expect, str, msg...)
+		return false
 	}
+	return true
 }
 
 // IsNaN asserts that the number value is NaN (Not a Number).
-func (a *NumberAssertion[T]) IsNaN(msg ...string) {
+func (a *NumberAssertion[T]) IsNaN(msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	if !isNaN(a.v) {
-		str := fmt.Sprintf("got (%T) %v but expect NaN", a.v, a.v)
+		str := fmt.Sprintf("got (%T) %v but expect NaN", a.v, show(a.t, a.v))
 		fail(a.t, str, msg...)
+		return false
 	}
+	return true
 }
 
 // IsInf asserts that the number value is infinite.
-func (a *NumberAssertion[T]) IsInf(sign int, msg ...string) {
+func (a *NumberAssertion[T]) IsInf(sign int, msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	if !isInf(a.v, sign) {
-		str := fmt.Sprintf("got (%T) %v but expect infinite with sign %d", a.v, a.v, sign)
+		str := fmt.Sprintf("got (%T) %v but expect infinite with sign %d", a.v, show(a.t, a.v), sign)
 		fail(a.t, str, msg...)
+		return false
 	}
+	return true
 }
 
 // IsFinite asserts that the number value is finite.
-func (a *NumberAssertion[T]) IsFinite(msg ...string) {
+func (a *NumberAssertion[T]) IsFinite(msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	if isNaN(a.v) || isInf(a.v, 0) {
-		str := fmt.Sprintf("got (%T) %v but expect finite", a.v, a.v)
+		str := fmt.Sprintf("got (%T) %v but expect finite", a.v, show(a.t, a.v))
 		fail(a.t, str, msg...)
+		return false
 	}
+	return true
 }
 
 // isNaN checks if the value is NaN.
@@ -0,0 +1,38 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/lvan100/go-assert/internal"
+)
+
+// OneOf asserts that got is one of the allowed values, as for enum-like
+// checks. The values are type-checked at compile time and the full allowed
+// list is printed on failure.
+func OneOf[T comparable](t internal.T, got T, allowed ...T) bool {
+	t.Helper()
+	defer track(t, got)()
+	if !slices.Contains(allowed, got) {
+		str := fmt.Sprintf("got (%T) %v but expect one of %v", got, show(t, got), show(t, allowed))
+		failValues(t, got, allowed, str)
+		return false
+	}
+	return true
+}
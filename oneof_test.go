@@ -0,0 +1,46 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert_test
+
+import (
+	"testing"
+
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/internal/mock"
+)
+
+type color string
+
+const (
+	red   color = "red"
+	green color = "green"
+	blue  color = "blue"
+)
+
+func TestOneOf(t *testing.T) {
+	runCase(t, func(g *mock.MockT) {
+		assert.OneOf(g, green, red, green, blue)
+		assert.OneOf(g, 200, 200, 204)
+	})
+
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"got (assert_test.color) pink but expect one of [red green blue]"})
+		g.EXPECT().Error([]interface{}{"got (int) 500 but expect one of []"})
+		assert.OneOf(g, color("pink"), red, green, blue)
+		assert.OneOf(g, 500)
+	})
+}
@@ -0,0 +1,254 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"reflect"
+	"time"
+
+	"github.com/lvan100/go-assert/internal"
+)
+
+// OrderedAssertion encapsulates a value of any ordered type, such as a
+// string or a custom type over one, and a test handler for making
+// comparison assertions on it. Numbers have the richer NumberAssertion.
+// NaN is not ordered: the comparisons fail when either side is NaN.
+type OrderedAssertion[T cmp.Ordered] struct {
+	t internal.T
+	v T
+}
+
+// ThatOrdered returns an OrderedAssertion for the given testing object and value.
+func ThatOrdered[T cmp.Ordered](t internal.T, v T) *OrderedAssertion[T] {
+	return &OrderedAssertion[T]{
+		t: t,
+		v: v,
+	}
+}
+
+// GreaterThan asserts that the value is greater than the expected value.
+func (a *OrderedAssertion[T]) GreaterThan(expect T, msg ...interface{}) (ok bool) {
+	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
+	if !(a.v > expect) {
+		str := fmt.Sprintf("got (%T) %v but expect greater than (%T) %v", a.v, show(a.t, a.v), expect, show(a.t, expect))
+		failValues(a.t, a.v, expect, str, msg...)
+		return false
+	}
+	return true
+}
+
+// GreaterOrEqual asserts that the value is greater than or equal to the expected value.
+func (a *OrderedAssertion[T]) GreaterOrEqual(expect T, msg ...interface{}) (ok bool) {
+	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
+	if !(a.v >= expect) {
+		str := fmt.Sprintf("got (%T) %v but expect greater than or equal to (%T) %v", a.v, show(a.t, a.v), expect, show(a.t, expect))
+		failValues(a.t, a.v, expect, str, msg...)
+		return false
+	}
+	return true
+}
+
+// LessThan asserts that the value is less than the expected value.
+func (a *OrderedAssertion[T]) LessThan(expect T, msg ...interface{}) (ok bool) {
+	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
+	if !(a.v < expect) {
+		str := fmt.Sprintf("got (%T) %v but expect less than (%T) %v", a.v, show(a.t, a.v), expect, show(a.t, expect))
+		failValues(a.t, a.v, expect, str, msg...)
+		return false
+	}
+	return true
+}
+
+// LessOrEqual asserts that the value is less than or equal to the expected value.
+func (a *OrderedAssertion[T]) LessOrEqual(expect T, msg ...interface{}) (ok bool) {
+	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
+	if !(a.v <= expect) {
+		str := fmt.Sprintf("got (%T) %v but expect less than or equal to (%T) %v", a.v, show(a.t, a.v), expect, show(a.t, expect))
+		failValues(a.t, a.v, expect, str, msg...)
+		return false
+	}
+	return true
+}
+
+// Between asserts that the value is between the lower and upper bounds (inclusive).
+func (a *OrderedAssertion[T]) Between(lower, upper T, msg ...interface{}) (ok bool) {
+	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
+	if !(a.v >= lower && a.v <= upper) {
+		str := fmt.Sprintf("got (%T) %v but expect between (%T) %v and (%T) %v", a.v, show(a.t, a.v), lower, show(a.t, lower), upper, show(a.t, upper))
+		failValues(a.t, a.v, [2]T{lower, upper}, str, msg...)
+		return false
+	}
+	return true
+}
+
+// compareOrdered compares x and y, which may be integers, floats or strings
+// of any type, or time.Time values. Integers and floats of different types
+// are compared by value. It returns false if the values are not comparable,
+// as NaN is with any number.
+func compareOrdered(x, y interface{}) (int, bool) {
+	if tx, ok := x.(time.Time); ok {
+		ty, ok := y.(time.Time)
+		return tx.Compare(ty), ok
+	}
+	vx, vy := reflect.ValueOf(x), reflect.ValueOf(y)
+	switch {
+	case vx.Kind() == reflect.String && vy.Kind() == reflect.String:
+		return cmp.Compare(vx.String(), vy.String()), true
+	case vx.CanInt() && vy.CanInt():
+		return cmp.Compare(vx.Int(), vy.Int()), true
+	case vx.CanUint() && vy.CanUint():
+		return cmp.Compare(vx.Uint(), vy.Uint()), true
+	case vx.CanInt() && vy.CanUint():
+		if vx.Int() < 0 {
+			return -1, true
+		}
+		return cmp.Compare(uint64(vx.Int()), vy.Uint()), true
+	case vx.CanUint() && vy.CanInt():
+		if vy.Int() < 0 {
+			return 1, true
+		}
+		return cmp.Compare(vx.Uint(), uint64(vy.Int())), true
+	}
+	fx, okx := floatOf(vx)
+	fy, oky := floatOf(vy)
+	if okx && oky && !math.IsNaN(fx) && !math.IsNaN(fy) {
+		return cmp.Compare(fx, fy), true
+	}
+	return 0, false
+}
+
+// floatOf returns the number v as a float64.
+func floatOf(v reflect.Value) (float64, bool) {
+	switch {
+	case v.CanFloat():
+		return v.Float(), true
+	case v.CanInt():
+		return float64(v.Int()), true
+	case v.CanUint():
+		return float64(v.Uint()), true
+	}
+	return 0, false
+}
+
+// compareWith compares the wrapped value v with expect, reporting an error
+// if they are not comparable, see compareOrdered.
+func (a *ThatAssertion) compareWith(expect interface{}, msg ...interface{}) (int, bool) {
+	a.t.Helper()
+	c, ok := compareOrdered(a.v, expect)
+	if !ok {
+		str := fmt.Sprintf("unable to compare (%T) %v with (%T) %v", a.v, show(a.t, a.v), expect, show(a.t, expect))
+		failUsage(a.t, str, msg...)
+	}
+	return c, ok
+}
+
+// GreaterThan asserts that the wrapped value v is greater than expect. The
+// values may be integers, floats or strings of any type, or time.Time
+// values; numbers of different types are compared by value.
+func (a *ThatAssertion) GreaterThan(expect interface{}, msg ...interface{}) (ok bool) {
+	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
+	c, ok := a.compareWith(expect, msg...)
+	if !ok {
+		return false
+	}
+	if c <= 0 {
+		str := fmt.Sprintf("got (%T) %v but expect greater than (%T) %v", a.v, show(a.t, a.v), expect, show(a.t, expect))
+		failValues(a.t, a.v, expect, str, msg...)
+		return false
+	}
+	return true
+}
+
+// GreaterOrEqual asserts that the wrapped value v is greater than or equal
+// to expect, see GreaterThan.
+func (a *ThatAssertion) GreaterOrEqual(expect interface{}, msg ...interface{}) (ok bool) {
+	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
+	c, ok := a.compareWith(expect, msg...)
+	if !ok {
+		return false
+	}
+	if c < 0 {
+		str := fmt.Sprintf("got (%T) %v but expect greater than or equal to (%T) %v", a.v, show(a.t, a.v), expect, show(a.t, expect))
+		failValues(a.t, a.v, expect, str, msg...)
+		return false
+	}
+	return true
+}
+
+// LessThan asserts that the wrapped value v is less than expect, see GreaterThan.
+func (a *ThatAssertion) LessThan(expect interface{}, msg ...interface{}) (ok bool) {
+	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
+	c, ok := a.compareWith(expect, msg...)
+	if !ok {
+		return false
+	}
+	if c >= 0 {
+		str := fmt.Sprintf("got (%T) %v but expect less than (%T) %v", a.v, show(a.t, a.v), expect, show(a.t, expect))
+		failValues(a.t, a.v, expect, str, msg...)
+		return false
+	}
+	return true
+}
+
+// LessOrEqual asserts that the wrapped value v is less than or equal to
+// expect, see GreaterThan.
+func (a *ThatAssertion) LessOrEqual(expect interface{}, msg ...interface{}) (ok bool) {
+	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
+	c, ok := a.compareWith(expect, msg...)
+	if !ok {
+		return false
+	}
+	if c > 0 {
+		str := fmt.Sprintf("got (%T) %v but expect less than or equal to (%T) %v", a.v, show(a.t, a.v), expect, show(a.t, expect))
+		failValues(a.t, a.v, expect, str, msg...)
+		return false
+	}
+	return true
+}
+
+// Between asserts that the wrapped value v is between the lower and upper
+// bounds (inclusive), see GreaterThan.
+func (a *ThatAssertion) Between(lower, upper interface{}, msg ...interface{}) (ok bool) {
+	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
+	lc, ok := a.compareWith(lower, msg...)
+	if !ok {
+		return false
+	}
+	uc, ok := a.compareWith(upper, msg...)
+	if !ok {
+		return false
+	}
+	if lc < 0 || uc > 0 {
+		str := fmt.Sprintf("got (%T) %v but expect between (%T) %v and (%T) %v", a.v, show(a.t, a.v), lower, show(a.t, lower), upper, show(a.t, upper))
+		failValues(a.t, a.v, [2]interface{}{lower, upper}, str, msg...)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,126 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/internal/mock"
+)
+
+type version string
+
+func TestOrdered(t *testing.T) {
+	runCase(t, func(g *mock.MockT) {
+		assert.ThatOrdered(g, "b").GreaterThan("a")
+		assert.ThatOrdered(g, "b").GreaterOrEqual("b")
+		assert.ThatOrdered(g, version("v1.2")).LessThan("v1.3")
+		assert.ThatOrdered(g, version("v1.2")).LessOrEqual("v1.2")
+		assert.ThatOrdered(g, "m").Between("a", "z")
+		assert.ThatOrdered(g, 2.5).Between(2.5, 2.5)
+	})
+
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"got (string) a but expect greater than (string) b"})
+		g.EXPECT().Error([]interface{}{"got (string) a but expect greater than or equal to (string) b"})
+		g.EXPECT().Error([]interface{}{"got (assert_test.version) v2 but expect less than (assert_test.version) v1\nmessage: upgrade"})
+		g.EXPECT().Error([]interface{}{"got (assert_test.version) v2 but expect less than or equal to (assert_test.version) v1"})
+		g.EXPECT().Error([]interface{}{"got (string) z but expect between (string) a and (string) m"})
+		assert.ThatOrdered(g, "a").GreaterThan("b")
+		assert.ThatOrdered(g, "a").GreaterOrEqual("b")
+		assert.ThatOrdered(g, version("v2")).LessThan("v1", "upgrade")
+		assert.ThatOrdered(g, version("v2")).LessOrEqual("v1")
+		assert.ThatOrdered(g, "z").Between("a", "m")
+	})
+
+	runCase(t, func(g *mock.MockT) {
+		nan := math.NaN()
+		g.EXPECT().Error([]interface{}{"got (float64) NaN but expect less than (float64) 1"})
+		g.EXPECT().Error([]interface{}{"got (float64) 1 but expect greater than (float64) NaN"})
+		g.EXPECT().Error([]interface{}{"got (float64) NaN but expect less than or equal to (float64) NaN"})
+		g.EXPECT().Error([]interface{}{"got (float64) NaN but expect between (float64) 0 and (float64) 1"})
+		assert.ThatOrdered(g, nan).LessThan(1)
+		assert.ThatOrdered(g, 1.0).GreaterThan(nan)
+		assert.ThatOrdered(g, nan).LessOrEqual(nan)
+		assert.ThatOrdered(g, nan).Between(0, 1)
+	})
+}
+
+func TestThat_Ordered(t *testing.T) {
+	now := time.Now()
+	runCase(t, func(g *mock.MockT) {
+		var got interface{} = float64(3) // e.g. decoded from JSON
+		assert.That(g, got).GreaterThan(2)
+		assert.That(g, got).GreaterOrEqual(uint8(3))
+		assert.That(g, -1).LessThan(uint(0))
+		assert.That(g, uint(5)).GreaterThan(-1)
+		assert.That(g, version("v1.2")).LessOrEqual("v1.2")
+		assert.That(g, now).Between(now.Add(-time.Second), now)
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"got (int) 2 but expect greater than (float64) 2.5"})
+		g.EXPECT().Error([]interface{}{"got (string) b but expect less than (string) a\nmessage: name"})
+		g.EXPECT().Error([]interface{}{"got (int64) 11 but expect between (int) 1 and (int) 10"})
+		g.EXPECT().Error([]interface{}{"unable to compare (string) 1 with (int) 1"})
+		assert.That(g, 2).GreaterThan(2.5)
+		assert.That(g, "b").LessThan("a", "name")
+		assert.That(g, int64(11)).Between(1, 10)
+		assert.That(g, "1").LessOrEqual(1)
+	})
+	runCase(t, func(g *mock.MockT) {
+		nan := math.NaN()
+		g.EXPECT().Error([]interface{}{"unable to compare (float64) NaN with (int) 1"})
+		g.EXPECT().Error([]interface{}{"unable to compare (int) 1 with (float64) NaN"})
+		g.EXPECT().Error([]interface{}{"unable to compare (float32) NaN with (int) 0"})
+		assert.That(g, nan).LessThan(1)
+		assert.That(g, 1).GreaterThan(nan)
+		assert.That(g, float32(nan)).Between(0, 1)
+	})
+}
+
+func TestThat_Ordered_Not(t *testing.T) {
+	runCase(t, func(g *mock.MockT) {
+		assert.ThatBool(t, assert.That(g, 1).Not().GreaterThan(2)).IsTrue()
+		assert.ThatBool(t, assert.That(g, 1).Not().Between(2, 3)).IsTrue()
+	})
+	// values that cannot be compared fail even if the check is inverted
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"unable to compare (string) 1 with (int) 1"})
+		g.EXPECT().Error([]interface{}{"unable to compare (float64) NaN with (int) 1"})
+		g.EXPECT().Error([]interface{}{"unable to compare (int) 1 with (string) a"})
+		assert.ThatBool(t, assert.That(g, "1").Not().GreaterThan(1)).IsFalse()
+		assert.ThatBool(t, assert.That(g, math.NaN()).Not().LessThan(1)).IsFalse()
+		assert.ThatBool(t, assert.That(g, 1).Not().Between(0, "a")).IsFalse()
+	})
+}
+
+func TestBetween_Values(t *testing.T) {
+	defer assert.SetMessageTemplate(assert.AnyOp, "{{show .Got}} not in {{show .Expect}}")()
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"11 not in [1 10]"})
+		g.EXPECT().Error([]interface{}{"z not in [a m]"})
+		g.EXPECT().Error([]interface{}{"0 not in [1 2]"})
+		g.EXPECT().Error([]interface{}{"5 not in [1 10]"})
+		assert.That(g, 11).Between(1, 10)
+		assert.ThatOrdered(g, "z").Between("a", "m")
+		assert.ThatNumber(g, 0).Between(1, 2)
+		assert.ThatNumber(g, 5).NotBetween(1, 10)
+	})
+}
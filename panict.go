@@ -0,0 +1,82 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"fmt"
+
+	"github.com/lvan100/go-assert/internal"
+)
+
+// PanicT returns a test handler that panics with the failure message on the
+// first failing assertion, so that assertions can check invariants outside
+// of tests, e.g. in examples, fuzz harness setup or scripts:
+//
+//	assert.ThatString(assert.PanicT(), cfg.Addr).IsNotEmpty()
+//
+// Failure messages are never colored. Logs are discarded and cleanup
+// functions are never called, as there is no test to finish: assertions
+// are not counted for it and hooks registered with OnFailure are ignored.
+func PanicT() internal.T {
+	return panicT{}
+}
+
+type panicT struct{}
+
+func (panicT) Helper() {}
+
+func (panicT) Error(args ...interface{}) {
+	panic(fmt.Sprint(args...))
+}
+
+func (panicT) Errorf(format string, args ...interface{}) {
+	panic(fmt.Sprintf(format, args...))
+}
+
+func (panicT) Fatal(args ...interface{}) {
+	panic(fmt.Sprint(args...))
+}
+
+func (panicT) Fatalf(format string, args ...interface{}) {
+	panic(fmt.Sprintf(format, args...))
+}
+
+func (panicT) Logf(format string, args ...interface{}) {}
+
+func (panicT) Name() string {
+	return ""
+}
+
+func (panicT) Cleanup(fn func()) {}
+
+// Skip panics with the skip message, as there is no test to skip.
+func (panicT) Skip(args ...interface{}) {
+	panic(fmt.Sprint(args...))
+}
+
+// Plain implements internal.Plain.
+func (panicT) Plain() {}
+
+// Endless implements internal.Endless.
+func (panicT) Endless() {}
+
+// endless reports whether the test of t never ends, so that no per-test
+// state can be kept for it.
+func endless(t internal.T) bool {
+	_, ok := findT[internal.Endless](t)
+	return ok
+}
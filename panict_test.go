@@ -0,0 +1,54 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert_test
+
+import (
+	"testing"
+
+	"github.com/lvan100/go-assert"
+)
+
+func TestPanicT(t *testing.T) {
+	assert.ThatString(assert.PanicT(), "localhost:8080").IsNotEmpty()
+
+	assert.Panic(t, func() {
+		assert.ThatString(assert.PanicT(), "").IsNotEmpty("addr")
+	}, `^string is empty:\n.*\n expect: non-empty string\nmessage: addr$`)
+
+	// no per-test state is kept, as the cleanups of PanicT never run
+	called := false
+	assert.OnFailure(assert.PanicT(), func(f assert.Failure) { called = true })
+	assert.RequireAssertions(assert.PanicT(), 1)
+	stats := assert.Stats(assert.PanicT())
+	assert.Panic(t, func() {
+		assert.ThatString(assert.PanicT(), "").IsNotEmpty()
+	}, `^string is empty`)
+	assert.False(t, called)
+	assert.That(t, stats.Failures()).Equal(0)
+
+	assert.Panic(t, func() {
+		assert.SkipUnless(assert.PanicT(), false, "requires %s", "docker")
+	}, `^requires docker$`)
+
+	defer assert.Configure(func(s *assert.Settings) {
+		s.Color = assert.ColorAlways
+	})()
+	assert.Panic(t, func() {
+		a := assert.New(assert.PanicT()).WithContext("config")
+		assert.That(a, 1).Equal(2)
+	}, `^config: got \(int\) 1 but expect \(int\) 2$`)
+}
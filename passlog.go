@@ -0,0 +1,88 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/lvan100/go-assert/internal"
+)
+
+// failures holds the number of failures reported by each test whose
+// passing assertions are logged or whose failures are counted by Stats,
+// keyed by its innermost test handler.
+var failures sync.Map // map[internal.T]*testCounter
+
+// logPassesOf reports whether passing assertions made through t are logged:
+// as set by the outermost Asserter setting it, or else by the package-level
+// setting.
+func logPassesOf(t internal.T) bool {
+	for _, a := range asserterOf(t) {
+		if a.logPasses != nil {
+			return *a.logPasses
+		}
+	}
+	return currentSettings().LogPasses
+}
+
+// failuresOf returns the failure counter of the test of t, creating it.
+func failuresOf(t internal.T) *testCounter {
+	key := baseT(t)
+	if endless(t) {
+		return newTestCounter(key)
+	}
+	if c, ok := failures.Load(key); ok {
+		return c.(*testCounter)
+	}
+	c, loaded := failures.LoadOrStore(key, newTestCounter(key))
+	if !loaded {
+		t.Cleanup(func() { failures.Delete(key) })
+	}
+	return c.(*testCounter)
+}
+
+// countFailure counts a failure of the test of t for it and the tests it
+// is a subtest of, if they have a counter.
+func countFailure(t internal.T) {
+	key := baseT(t)
+	name := nameOf(key)
+	failures.Range(func(_, v interface{}) bool {
+		if c := v.(*testCounter); c.counts(key, name) {
+			c.Add(1)
+		}
+		return true
+	})
+}
+
+// logPass logs a passing assertion with its condensed subject value.
+func logPass(t internal.T, op string, v interface{}) {
+	t.Helper()
+	t.Logf("%s", passLine(op, v))
+}
+
+// passLine renders the log line of a passing assertion.
+func passLine(op string, v interface{}) string {
+	if v == nil {
+		return "ok " + op
+	}
+	format := "ok %s (%T) %v"
+	if _, ok := v.(string); ok {
+		format = "ok %s (%T) %q"
+	}
+	return fmt.Sprintf(format, op, v, shownValue{v: v, verbosity: VerbosityCompact})
+}
@@ -0,0 +1,65 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/lvan100/go-assert"
+	"go.uber.org/mock/gomock"
+)
+
+// loggingT records the lines logged through the mock.
+type loggingT struct {
+	*namedT
+	logs []string
+}
+
+func (t *loggingT) Logf(format string, args ...interface{}) {
+	t.logs = append(t.logs, fmt.Sprintf(format, args...))
+}
+
+func TestLogPasses(t *testing.T) {
+	t.Run("asserter", func(t *testing.T) {
+		runNamedCase(t, t.Name(), func(g *namedT) {
+			g.EXPECT().Error(gomock.Any())
+			lt := &loggingT{namedT: g}
+			a := assert.New(lt).WithLogPasses(true)
+			assert.True(a, true)
+			assert.ThatString(a, strings.Repeat("x", 100)).HasPrefix("x").Equal("y")
+			assert.ThatFile(a, "passlog_test.go").Exists()
+			assert.ThatNumber(a, 3).GreaterThan(1)
+			assert.ThatString(t, strings.Join(lt.logs, "\n")).Equal(`ok True (bool) true
+ok StringAssertion.HasPrefix (string) "xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx... (22 more bytes)
+ok FileAssertion.Exists
+ok NumberAssertion.GreaterThan (int) 3`)
+		})
+	})
+	t.Run("global", func(t *testing.T) {
+		defer assert.Configure(func(s *assert.Settings) {
+			s.LogPasses = true
+		})()
+		runNamedCase(t, t.Name(), func(g *namedT) {
+			lt := &loggingT{namedT: g}
+			assert.That(lt, 1).Equal(1)
+			assert.That(assert.New(lt).WithLogPasses(false), 2).Equal(2)
+			assert.ThatString(t, strings.Join(lt.logs, "\n")).Equal(`ok ThatAssertion.Equal (int) 1`)
+		})
+	})
+}
@@ -0,0 +1,174 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// prettyPrint renders v as an indented, Go-like multi-line representation.
+// Map keys are sorted so that the output is deterministic, and pointer
+// cycles are cut off instead of being followed forever.
+func prettyPrint(v interface{}) string {
+	p := &printer{seen: make(map[uintptr]bool)}
+	p.print(reflect.ValueOf(v), 0)
+	return p.buf.String()
+}
+
+// prettyFormat is like prettyPrint but renders values with the formatters
+// registered by RegisterFormatter. It is meant for failure messages, whereas
+// snapshots use prettyPrint so that their content does not depend on them.
+func prettyFormat(v interface{}) string {
+	p := &printer{seen: make(map[uintptr]bool), format: true}
+	p.print(reflect.ValueOf(v), 0)
+	return p.buf.String()
+}
+
+type printer struct {
+	buf    strings.Builder
+	seen   map[uintptr]bool
+	format bool // use registered formatters
+}
+
+func (p *printer) indent(depth int) {
+	p.buf.WriteString(strings.Repeat("  ", depth))
+}
+
+func (p *printer) print(v reflect.Value, depth int) {
+	if !v.IsValid() {
+		p.buf.WriteString("nil")
+		return
+	}
+	if p.format {
+		if s, ok := formatted(v); ok {
+			p.buf.WriteString(s)
+			return
+		}
+	}
+	if v.CanInterface() && v.Kind() == reflect.Struct {
+		if s, ok := v.Interface().(fmt.Stringer); ok {
+			p.buf.WriteString(v.Type().String() + "(" + strconv.Quote(s.String()) + ")")
+			return
+		}
+	}
+	switch v.Kind() {
+	case reflect.Bool:
+		p.buf.WriteString(strconv.FormatBool(v.Bool()))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		p.buf.WriteString(strconv.FormatInt(v.Int(), 10))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		p.buf.WriteString(strconv.FormatUint(v.Uint(), 10))
+	case reflect.Float32, reflect.Float64:
+		p.buf.WriteString(strconv.FormatFloat(v.Float(), 'g', -1, v.Type().Bits()))
+	case reflect.Complex64, reflect.Complex128:
+		p.buf.WriteString(fmt.Sprint(v.Complex()))
+	case reflect.String:
+		p.buf.WriteString(strconv.Quote(v.String()))
+	case reflect.Interface:
+		if v.IsNil() {
+			p.buf.WriteString("nil")
+			return
+		}
+		p.print(v.Elem(), depth)
+	case reflect.Ptr:
+		if v.IsNil() {
+			p.buf.WriteString("nil")
+			return
+		}
+		if p.seen[v.Pointer()] {
+			p.buf.WriteString("<cycle>")
+			return
+		}
+		p.seen[v.Pointer()] = true
+		defer delete(p.seen, v.Pointer())
+		p.buf.WriteString("&")
+		p.print(v.Elem(), depth)
+	case reflect.Struct:
+		t := v.Type()
+		if v.NumField() == 0 {
+			p.buf.WriteString(t.String() + "{}")
+			return
+		}
+		p.buf.WriteString(t.String() + "{\n")
+		for i := 0; i < v.NumField(); i++ {
+			p.indent(depth + 1)
+			p.buf.WriteString(t.Field(i).Name + ": ")
+			p.print(v.Field(i), depth+1)
+			p.buf.WriteString(",\n")
+		}
+		p.indent(depth)
+		p.buf.WriteString("}")
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			p.buf.WriteString(v.Type().String() + "(nil)")
+			return
+		}
+		if v.Len() == 0 {
+			p.buf.WriteString(v.Type().String() + "{}")
+			return
+		}
+		p.buf.WriteString(v.Type().String() + "{\n")
+		for i := 0; i < v.Len(); i++ {
+			p.indent(depth + 1)
+			p.print(v.Index(i), depth+1)
+			p.buf.WriteString(",\n")
+		}
+		p.indent(depth)
+		p.buf.WriteString("}")
+	case reflect.Map:
+		if v.IsNil() {
+			p.buf.WriteString(v.Type().String() + "(nil)")
+			return
+		}
+		if v.Len() == 0 {
+			p.buf.WriteString(v.Type().String() + "{}")
+			return
+		}
+		type entry struct {
+			key string
+			val reflect.Value
+		}
+		var entries []entry
+		for _, k := range v.MapKeys() {
+			kp := &printer{seen: p.seen, format: p.format}
+			kp.print(k, 0)
+			entries = append(entries, entry{kp.buf.String(), v.MapIndex(k)})
+		}
+		sort.Slice(entries, func(i, j int) bool { return entries[i].key < entries[j].key })
+		p.buf.WriteString(v.Type().String() + "{\n")
+		for _, e := range entries {
+			p.indent(depth + 1)
+			p.buf.WriteString(e.key + ": ")
+			p.print(e.val, depth+1)
+			p.buf.WriteString(",\n")
+		}
+		p.indent(depth)
+		p.buf.WriteString("}")
+	case reflect.Chan, reflect.Func, reflect.UnsafePointer:
+		if v.IsNil() {
+			p.buf.WriteString(v.Type().String() + "(nil)")
+			return
+		}
+		p.buf.WriteString(v.Type().String())
+	default:
+		p.buf.WriteString(v.String())
+	}
+}
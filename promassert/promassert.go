@@ -0,0 +1,203 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package promassert offers assertions on Prometheus metrics, kept apart
+// from package assert so that only the tests using them depend on the
+// Prometheus client:
+//
+//	promassert.ThatMetrics(t, registry).
+//		GaugeEquals("inflight", nil, 3).
+//		HistogramCountAtLeast("latency_seconds", nil, 2)
+package promassert
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/lvan100/go-assert"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+// MetricsAssertion encapsulates a Prometheus gatherer, such as a registry,
+// and a test handler for making assertions on the metrics it gathers. Each
+// assertion gathers afresh. A series is identified by its metric name and
+// its exact set of labels.
+type MetricsAssertion struct {
+	t assert.T
+	g prometheus.Gatherer
+}
+
+// ThatMetrics returns a MetricsAssertion for the given testing object and
+// gatherer.
+func ThatMetrics(t assert.T, g prometheus.Gatherer) *MetricsAssertion {
+	return &MetricsAssertion{
+		t: assert.Chain(t),
+		g: g,
+	}
+}
+
+// find gathers the metrics and returns the series of the named metric with
+// exactly the given labels, or the failure if it is not found.
+func (a *MetricsAssertion) find(name string, labels prometheus.Labels, typ dto.MetricType) (*dto.Metric, assert.Result) {
+	m, present, err := a.gather(name, labels, typ)
+	if err != nil {
+		return nil, assert.ResultFailure("%s", err)
+	}
+	if m == nil {
+		str := fmt.Sprintf(`metric not found:
+   name: %q
+ labels: %s`, name, formatLabels(labels))
+		if len(present) > 0 {
+			str += "\npresent: " + strings.Join(present, "\n         ")
+		}
+		return nil, assert.ResultFailure("%s", str)
+	}
+	return m, assert.ResultSuccess()
+}
+
+// gather returns the series of the named metric with exactly the given
+// labels, or nil and the label sets of its series if there is none.
+func (a *MetricsAssertion) gather(name string, labels prometheus.Labels, typ dto.MetricType) (*dto.Metric, []string, error) {
+	families, err := a.g.Gather()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to gather metrics: %v", err)
+	}
+	for _, f := range families {
+		if f.GetName() != name {
+			continue
+		}
+		if f.GetType() != typ {
+			return nil, nil, fmt.Errorf("metric %q is a %s, not a %s", name, f.GetType(), typ)
+		}
+		var present []string
+		for _, m := range f.GetMetric() {
+			if labelsEqual(m.GetLabel(), labels) {
+				return m, nil, nil
+			}
+			present = append(present, formatLabelPairs(m.GetLabel()))
+		}
+		return nil, present, nil
+	}
+	return nil, nil, nil
+}
+
+// GaugeEquals reports a test failure if the gauge series is not found or
+// its value is not equal to value.
+func (a *MetricsAssertion) GaugeEquals(name string, labels prometheus.Labels, value float64, msg ...interface{}) *MetricsAssertion {
+	a.t.Helper()
+	assert.Check(a.t, func() assert.Result {
+		m, r := a.find(name, labels, dto.MetricType_GAUGE)
+		if !r.Success() {
+			return r
+		}
+		if m.GetGauge().GetValue() != value {
+			return assert.ResultFailure(`gauge mismatch:
+   name: %q
+ labels: %s
+    got: %v
+ expect: %v`, name, formatLabels(labels), m.GetGauge().GetValue(), value)
+		}
+		return assert.ResultSuccess()
+	}, msg...)
+	return a
+}
+
+// CounterDeltaIs runs fn and reports a test failure if the counter series
+// did not increase by exactly delta. A series absent before fn counts as
+// zero.
+func (a *MetricsAssertion) CounterDeltaIs(name string, labels prometheus.Labels, delta float64, fn func(), msg ...interface{}) *MetricsAssertion {
+	a.t.Helper()
+	assert.Check(a.t, func() assert.Result {
+		before, _, err := a.gather(name, labels, dto.MetricType_COUNTER)
+		if err != nil {
+			return assert.ResultFailure("%s", err)
+		}
+		fn()
+		after, r := a.find(name, labels, dto.MetricType_COUNTER)
+		if !r.Success() {
+			return r
+		}
+		if got := after.GetCounter().GetValue() - before.GetCounter().GetValue(); got != delta {
+			return assert.ResultFailure(`counter delta mismatch:
+   name: %q
+ labels: %s
+    got: %v
+ expect: %v`, name, formatLabels(labels), got, delta)
+		}
+		return assert.ResultSuccess()
+	}, msg...)
+	return a
+}
+
+// HistogramCountAtLeast reports a test failure if the histogram series is
+// not found or has fewer than n observations.
+func (a *MetricsAssertion) HistogramCountAtLeast(name string, labels prometheus.Labels, n uint64, msg ...interface{}) *MetricsAssertion {
+	a.t.Helper()
+	assert.Check(a.t, func() assert.Result {
+		m, r := a.find(name, labels, dto.MetricType_HISTOGRAM)
+		if !r.Success() {
+			return r
+		}
+		if m.GetHistogram().GetSampleCount() < n {
+			return assert.ResultFailure(`histogram count too low:
+   name: %q
+ labels: %s
+    got: %d
+ expect: at least %d`, name, formatLabels(labels), m.GetHistogram().GetSampleCount(), n)
+		}
+		return assert.ResultSuccess()
+	}, msg...)
+	return a
+}
+
+// labelsEqual reports whether pairs are exactly the given labels.
+func labelsEqual(pairs []*dto.LabelPair, labels prometheus.Labels) bool {
+	if len(pairs) != len(labels) {
+		return false
+	}
+	for _, p := range pairs {
+		if v, ok := labels[p.GetName()]; !ok || v != p.GetValue() {
+			return false
+		}
+	}
+	return true
+}
+
+// formatLabels formats labels in the Prometheus exposition format, sorted
+// by name.
+func formatLabels(labels prometheus.Labels) string {
+	names := make([]string, 0, len(labels))
+	for k := range labels {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	parts := make([]string, len(names))
+	for i, k := range names {
+		parts[i] = fmt.Sprintf("%s=%q", k, labels[k])
+	}
+	return "{" + strings.Join(parts, ", ") + "}"
+}
+
+// formatLabelPairs is like formatLabels for the labels of a series.
+func formatLabelPairs(pairs []*dto.LabelPair) string {
+	labels := make(prometheus.Labels, len(pairs))
+	for _, p := range pairs {
+		labels[p.GetName()] = p.GetValue()
+	}
+	return formatLabels(labels)
+}
@@ -0,0 +1,112 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package promassert_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/internal/mock"
+	"github.com/lvan100/go-assert/promassert"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/mock/gomock"
+)
+
+func TestMain(m *testing.M) {
+	assert.Configure(func(s *assert.Settings) {
+		s.Color = assert.ColorNever
+	})
+	os.Exit(m.Run())
+}
+
+func runCase(t *testing.T, f func(g *mock.MockT)) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	g := mock.NewMockT(ctrl)
+	g.EXPECT().Helper().AnyTimes()
+	f(g)
+}
+
+func TestMetrics(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	inflight := prometheus.NewGauge(prometheus.GaugeOpts{Name: "inflight"})
+	requests := prometheus.NewCounterVec(prometheus.CounterOpts{Name: "requests_total"}, []string{"code"})
+	latency := prometheus.NewHistogram(prometheus.HistogramOpts{Name: "latency_seconds"})
+	reg.MustRegister(inflight, requests, latency)
+
+	inflight.Set(3)
+	requests.WithLabelValues("200").Inc()
+	latency.Observe(0.1)
+	latency.Observe(0.2)
+
+	runCase(t, func(g *mock.MockT) {
+		promassert.ThatMetrics(g, reg).
+			GaugeEquals("inflight", nil, 3).
+			CounterDeltaIs("requests_total", prometheus.Labels{"code": "200"}, 2, func() {
+				requests.WithLabelValues("200").Add(2)
+			}).
+			CounterDeltaIs("requests_total", prometheus.Labels{"code": "500"}, 1, func() {
+				requests.WithLabelValues("500").Inc()
+			}).
+			HistogramCountAtLeast("latency_seconds", nil, 2)
+	})
+
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`gauge mismatch:
+   name: "inflight"
+ labels: {}
+    got: 3
+ expect: 4`})
+		promassert.ThatMetrics(g, reg).GaugeEquals("inflight", nil, 4)
+	})
+
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`metric not found:
+   name: "requests_total"
+ labels: {code="404"}
+present: {code="200"}
+         {code="500"}`})
+		promassert.ThatMetrics(g, reg).CounterDeltaIs("requests_total", prometheus.Labels{"code": "404"}, 1, func() {})
+	})
+
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`counter delta mismatch:
+   name: "requests_total"
+ labels: {code="200"}
+    got: 0
+ expect: 1`})
+		promassert.ThatMetrics(g, reg).CounterDeltaIs("requests_total", prometheus.Labels{"code": "200"}, 1, func() {})
+	})
+
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`histogram count too low:
+   name: "latency_seconds"
+ labels: {}
+    got: 2
+ expect: at least 5`})
+		promassert.ThatMetrics(g, reg).HistogramCountAtLeast("latency_seconds", nil, 5)
+	})
+
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`metric "inflight" is a GAUGE, not a COUNTER`})
+		g.EXPECT().Error([]interface{}{"metric not found:\n   name: \"missing\"\n labels: {}"})
+		promassert.ThatMetrics(assert.New(g).WithContinueChains(true), reg).
+			CounterDeltaIs("inflight", nil, 1, func() {}).
+			GaugeEquals("missing", nil, 0)
+	})
+}
@@ -0,0 +1,90 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"fmt"
+
+	"github.com/lvan100/go-assert/internal"
+)
+
+// PtrAssertion encapsulates a typed pointer and a test handler for making
+// assertions on it and its pointee without risking a nil dereference in
+// test code, e.g. for optional fields.
+type PtrAssertion[T any] struct {
+	t internal.T
+	p *T
+}
+
+// ThatPtr returns a PtrAssertion for the given testing object and pointer.
+func ThatPtr[T any](t internal.T, p *T) *PtrAssertion[T] {
+	return &PtrAssertion[T]{
+		t: chain(t),
+		p: p,
+	}
+}
+
+// IsNil reports a test failure if the pointer is not nil.
+func (a *PtrAssertion[T]) IsNil(msg ...interface{}) *PtrAssertion[T] {
+	a.t.Helper()
+	defer track(a.t, a.p)()
+	if a.p != nil {
+		str := fmt.Sprintf("got (%T) pointer to %v but expect nil", a.p, show(a.t, *a.p))
+		fail(a.t, str, msg...)
+	}
+	return a
+}
+
+// IsNotNil reports a test failure if the pointer is nil.
+func (a *PtrAssertion[T]) IsNotNil(msg ...interface{}) *PtrAssertion[T] {
+	a.t.Helper()
+	defer track(a.t, a.p)()
+	if a.p == nil {
+		fail(a.t, fmt.Sprintf("got (%T) nil but expect not nil", a.p), msg...)
+	}
+	return a
+}
+
+// PointsToValue reports a test failure if the pointer is nil or the value
+// it points to is not deeply equal to want.
+func (a *PtrAssertion[T]) PointsToValue(want T, msg ...interface{}) *PtrAssertion[T] {
+	a.t.Helper()
+	defer track(a.t, a.p)()
+	if a.p == nil {
+		str := fmt.Sprintf("got (%T) nil but expect pointer to (%T) %v", a.p, want, show(a.t, want))
+		fail(a.t, str, msg...)
+		return a
+	}
+	if equal, ok := equalValues(a.t, *a.p, want, msg...); ok && !equal {
+		str := fmt.Sprintf("got pointer to (%T) %v but expect pointer to (%T) %v", *a.p, show(a.t, *a.p), want, show(a.t, want))
+		failValues(a.t, *a.p, want, str, msg...)
+	}
+	return a
+}
+
+// Deref returns a TypedAssertion over the value the pointer points to. If
+// the pointer is nil it reports a test failure and the returned assertion
+// is over the zero value of T.
+func (a *PtrAssertion[T]) Deref(msg ...interface{}) *TypedAssertion[T] {
+	a.t.Helper()
+	if a.p == nil {
+		fail(a.t, fmt.Sprintf("cannot dereference (%T) nil", a.p), msg...)
+		var zero T
+		return ThatT(a.t, zero)
+	}
+	return ThatT(a.t, *a.p)
+}
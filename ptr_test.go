@@ -0,0 +1,61 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert_test
+
+import (
+	"testing"
+
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/internal/mock"
+)
+
+func TestPtr(t *testing.T) {
+	type User struct {
+		Name string
+		Age  *int
+	}
+	age := 30
+
+	runCase(t, func(g *mock.MockT) {
+		u := User{Name: "bob", Age: &age}
+		assert.ThatPtr(g, u.Age).IsNotNil()
+		assert.ThatPtr(g, u.Age).PointsToValue(30)
+		assert.ThatPtr(g, u.Age).Deref().Equal(30)
+		var typed *assert.TypedAssertion[int] = assert.ThatPtr(g, u.Age).Deref()
+		typed.Satisfies(func(v int) bool { return v >= 18 }, "an adult age")
+		assert.ThatPtr[int](g, nil).IsNil()
+	})
+
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"got (*int) pointer to 30 but expect nil"})
+		g.EXPECT().Error([]interface{}{"got pointer to (int) 30 but expect pointer to (int) 31\nmessage: age"})
+		g.EXPECT().Error([]interface{}{"got (int) 30 but expect (int) 31"})
+		assert.ThatPtr(g, &age).IsNil()
+		assert.ThatPtr(g, &age).PointsToValue(31, "age")
+		assert.ThatPtr(g, &age).Deref().Equal(31)
+	})
+
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"got (*int) nil but expect not nil"})
+		g.EXPECT().Error([]interface{}{"got (*int) nil but expect pointer to (int) 30"})
+		g.EXPECT().Error([]interface{}{"cannot dereference (*int) nil"})
+		var u User
+		assert.ThatPtr(g, u.Age).IsNotNil()
+		assert.ThatPtr(g, u.Age).PointsToValue(30)
+		assert.ThatPtr(g, u.Age).Deref()
+	})
+}
@@ -0,0 +1,251 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/lvan100/go-assert/internal"
+)
+
+// streamChunkSize is the number of bytes read from each side at a time
+// when comparing streams.
+const streamChunkSize = 32 * 1024
+
+// streamWindow is the number of bytes shown around a mismatch.
+const streamWindow = 16
+
+// ReaderAssertion encapsulates an io.Reader and a test handler for making
+// assertions on the stream content. Assertions read the stream incrementally
+// and never buffer it entirely in memory. Each assertion consumes the bytes
+// it inspects, so chained assertions continue where the previous one stopped.
+type ReaderAssertion struct {
+	t      internal.T
+	r      io.Reader
+	limit  int64 // negative means unlimited
+	format string
+	raw    *countingReader // compressed input, see Decompressed
+}
+
+// ThatReader returns a ReaderAssertion for the given testing object and reader.
+func ThatReader(t internal.T, r io.Reader) *ReaderAssertion {
+	return &ReaderAssertion{
+		t:     chain(t),
+		r:     r,
+		limit: -1,
+	}
+}
+
+// LimitedTo restricts the following assertions to at most n bytes of the
+// stream. Content comparisons also only consider the first n bytes of the
+// expected content.
+func (a *ReaderAssertion) LimitedTo(n int64) *ReaderAssertion {
+	return &ReaderAssertion{
+		t:      a.t,
+		r:      io.LimitReader(a.r, n),
+		limit:  n,
+		format: a.format,
+		raw:    a.raw,
+	}
+}
+
+// Decompressed returns a ReaderAssertion over the decoded stream if it is
+// compressed, detected from its magic number: gzip, or a format registered
+// with RegisterDecompressor. Failure messages then also report the format
+// and the number of compressed bytes read. Uncompressed streams are asserted
+// as they are. A test failure is reported if the compression header is
+// invalid.
+func (a *ReaderAssertion) Decompressed(msg ...interface{}) *ReaderAssertion {
+	a.t.Helper()
+	raw := &countingReader{r: a.r}
+	r, format, err := decompress(raw)
+	if err != nil {
+		str := fmt.Sprintf(`unable to decompress stream:
+ format: %s
+  error: %v`, format, err)
+		fail(a.t, str+a.note(), msg...)
+		return a
+	}
+	return &ReaderAssertion{
+		t:      a.t,
+		r:      r,
+		limit:  a.limit,
+		format: format,
+		raw:    raw,
+	}
+}
+
+// note returns the line appended to failure messages of decompressed streams.
+func (a *ReaderAssertion) note() string {
+	if a.format == "" {
+		return ""
+	}
+	return compressionNote(a.format, a.raw.n) + " read"
+}
+
+// ContentEqual reports a test failure if the remaining stream content is not
+// equal to expect, which may be an io.Reader, a string or a []byte. The first
+// mismatching offset is reported together with the bytes around it.
+func (a *ReaderAssertion) ContentEqual(expect interface{}, msg ...interface{}) *ReaderAssertion {
+	a.t.Helper()
+	defer track(a.t, nil)()
+	var r io.Reader
+	switch e := expect.(type) {
+	case io.Reader:
+		r = e
+	case string:
+		r = strings.NewReader(e)
+	case []byte:
+		r = bytes.NewReader(e)
+	default:
+		str := fmt.Sprintf("unsupported expect value (%T) %v", expect, expect)
+		fail(a.t, str+a.note(), msg...)
+		return a
+	}
+	if a.limit >= 0 {
+		r = io.LimitReader(r, a.limit)
+	}
+	d, err := compareStreams(a.r, r)
+	if err != nil {
+		str := fmt.Sprintf(`unable to read stream:
+  error: %v`, err)
+		fail(a.t, str+a.note(), msg...)
+		return a
+	}
+	if d != nil {
+		str := fmt.Sprintf(`stream content not equal:
+ offset: %d
+    got: %s
+ expect: %s`, d.offset, d.got, d.expect)
+		fail(a.t, str+a.note(), msg...)
+	}
+	return a
+}
+
+// HasPrefixBytes reports a test failure if the stream does not start with prefix.
+// Only len(prefix) bytes are read from the stream.
+func (a *ReaderAssertion) HasPrefixBytes(prefix []byte, msg ...interface{}) *ReaderAssertion {
+	a.t.Helper()
+	defer track(a.t, nil)()
+	b := make([]byte, len(prefix))
+	n, err := io.ReadFull(a.r, b)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		str := fmt.Sprintf(`unable to read stream:
+  error: %v`, err)
+		fail(a.t, str+a.note(), msg...)
+		return a
+	}
+	if !bytes.Equal(b[:n], prefix) {
+		str := fmt.Sprintf(`stream does not start with the specified prefix:
+    got: %q
+ expect: to have prefix %q`, b[:n], prefix)
+		fail(a.t, str+a.note(), msg...)
+	}
+	return a
+}
+
+// Len reports a test failure if the number of remaining bytes in the stream
+// is not equal to the expected length.
+func (a *ReaderAssertion) Len(length int64, msg ...interface{}) *ReaderAssertion {
+	a.t.Helper()
+	defer track(a.t, nil)()
+	n, err := io.Copy(io.Discard, a.r)
+	if err != nil {
+		str := fmt.Sprintf(`unable to read stream:
+  error: %v`, err)
+		fail(a.t, str+a.note(), msg...)
+		return a
+	}
+	if n != length {
+		str := fmt.Sprintf(`length mismatch:
+    got: length %d
+ expect: length %d`, n, length)
+		fail(a.t, str+a.note(), msg...)
+	}
+	return a
+}
+
+// streamDiff describes the first difference between two streams.
+type streamDiff struct {
+	offset int64
+	got    string
+	expect string
+}
+
+// compareStreams reads got and expect chunk by chunk and returns the first
+// difference, or nil if both streams have identical content.
+func compareStreams(got, expect io.Reader) (*streamDiff, error) {
+	bufG := make([]byte, streamChunkSize)
+	bufE := make([]byte, streamChunkSize)
+	var offset int64
+	for {
+		ng, err := readChunk(got, bufG)
+		if err != nil {
+			return nil, err
+		}
+		ne, err := readChunk(expect, bufE)
+		if err != nil {
+			return nil, err
+		}
+		n := min(ng, ne)
+		i := 0
+		for i < n && bufG[i] == bufE[i] {
+			i++
+		}
+		if i < n || ng != ne {
+			return &streamDiff{
+				offset: offset + int64(i),
+				got:    window(bufG[:ng], i),
+				expect: window(bufE[:ne], i),
+			}, nil
+		}
+		if ng < len(bufG) {
+			return nil, nil
+		}
+		offset += int64(n)
+	}
+}
+
+// readChunk fills buf as far as possible, treating the end of the stream as success.
+func readChunk(r io.Reader, buf []byte) (int, error) {
+	n, err := io.ReadFull(r, buf)
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		err = nil
+	}
+	return n, err
+}
+
+// window renders the bytes of b around index i, marking truncation and the
+// end of the stream.
+func window(b []byte, i int) string {
+	start := max(i-streamWindow, 0)
+	end := min(i+streamWindow, len(b))
+	s := fmt.Sprintf("%q", b[start:end])
+	if start > 0 {
+		s = "..." + s
+	}
+	if end < len(b) {
+		s += "..."
+	} else if len(b) < streamChunkSize {
+		s += " <EOF>"
+	}
+	return s
+}
@@ -0,0 +1,97 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/internal/mock"
+)
+
+func TestReader_ContentEqual(t *testing.T) {
+	runCase(t, func(g *mock.MockT) {
+		assert.ThatReader(g, strings.NewReader("hello, world!")).ContentEqual("hello, world!")
+		assert.ThatReader(g, strings.NewReader("hello, world!")).ContentEqual([]byte("hello, world!"))
+		assert.ThatReader(g, strings.NewReader("hello, world!")).ContentEqual(strings.NewReader("hello, world!"))
+	})
+	runCase(t, func(g *mock.MockT) {
+		big := bytes.Repeat([]byte("0123456789"), 10000)
+		assert.ThatReader(g, bytes.NewReader(big)).ContentEqual(bytes.NewReader(big))
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`stream content not equal:
+ offset: 7
+    got: "hello, world!" <EOF>
+ expect: "hello, there!" <EOF>`})
+		assert.ThatReader(g, strings.NewReader("hello, world!")).ContentEqual("hello, there!")
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`stream content not equal:
+ offset: 5
+    got: "hello" <EOF>
+ expect: "hello, world!" <EOF>
+message: param (index=0)`})
+		assert.ThatReader(g, strings.NewReader("hello")).ContentEqual("hello, world!", "param (index=0)")
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"unsupported expect value (int) 3"})
+		assert.ThatReader(g, strings.NewReader("hello")).ContentEqual(3)
+	})
+}
+
+func TestReader_LargeMismatch(t *testing.T) {
+	runCase(t, func(g *mock.MockT) {
+		got := bytes.Repeat([]byte("a"), 100000)
+		expect := bytes.Clone(got)
+		expect[70000] = 'b'
+		g.EXPECT().Error([]interface{}{`stream content not equal:
+ offset: 70000
+    got: ..."aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"...
+ expect: ..."aaaaaaaaaaaaaaaabaaaaaaaaaaaaaaa"...`})
+		assert.ThatReader(g, bytes.NewReader(got)).ContentEqual(expect)
+	})
+}
+
+func TestReader_HasPrefixBytes(t *testing.T) {
+	runCase(t, func(g *mock.MockT) {
+		assert.ThatReader(g, strings.NewReader("hello, world!")).
+			HasPrefixBytes([]byte("hello")).
+			ContentEqual(", world!")
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`stream does not start with the specified prefix:
+    got: "he"
+ expect: to have prefix "hello"`})
+		assert.ThatReader(g, strings.NewReader("he")).HasPrefixBytes([]byte("hello"))
+	})
+}
+
+func TestReader_LimitedTo(t *testing.T) {
+	runCase(t, func(g *mock.MockT) {
+		assert.ThatReader(g, strings.NewReader("hello, world!")).LimitedTo(5).ContentEqual("hello, there!")
+		assert.ThatReader(g, strings.NewReader("hello, world!")).LimitedTo(5).Len(5)
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`length mismatch:
+    got: length 13
+ expect: length 5`})
+		assert.ThatReader(g, strings.NewReader("hello, world!")).Len(5)
+	})
+}
@@ -0,0 +1,137 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/lvan100/go-assert/internal"
+)
+
+// Recorder records the sequence of events, such as calls, pushed into it by
+// code under test or test doubles, for assertions with ThatRecorder. Its
+// zero value is ready to use and it is safe for concurrent use:
+//
+//	var rec assert.Recorder
+//	f := &fakeFile{onWrite: func() { rec.Record("write") }}
+//	...
+//	assert.ThatRecorder(t, &rec).CallsInOrder("open", "write", "close").NoCall("delete")
+type Recorder struct {
+	mu     sync.Mutex
+	events []string
+}
+
+// Record appends the event name to the recorded sequence.
+func (r *Recorder) Record(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, name)
+}
+
+// Events returns the recorded sequence.
+func (r *Recorder) Events() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string{}, r.events...)
+}
+
+// Reset discards the recorded sequence.
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = nil
+}
+
+// RecorderAssertion encapsulates the sequence recorded by a Recorder and a
+// test handler for making assertions on it. Failure messages print the
+// full recorded sequence.
+type RecorderAssertion struct {
+	t      internal.T
+	events []string
+}
+
+// ThatRecorder returns a RecorderAssertion for the given testing object and
+// the events recorded so far by r.
+func ThatRecorder(t internal.T, r *Recorder) *RecorderAssertion {
+	return &RecorderAssertion{
+		t:      chain(t),
+		events: r.Events(),
+	}
+}
+
+// CallsInOrder reports a test failure unless the given events were recorded
+// in this order, possibly with other events between them.
+func (a *RecorderAssertion) CallsInOrder(names ...string) *RecorderAssertion {
+	a.t.Helper()
+	defer track(a.t, nil)()
+	i := 0
+	for _, e := range a.events {
+		if i < len(names) && e == names[i] {
+			i++
+		}
+	}
+	if i < len(names) {
+		str := fmt.Sprintf(`calls not in order:
+    got: %q
+ expect: %q
+missing: %q after %q`, a.events, names, names[i], names[:i])
+		fail(a.t, str)
+	}
+	return a
+}
+
+// CallCount reports a test failure if the event name was not recorded
+// exactly n times.
+func (a *RecorderAssertion) CallCount(name string, n int, msg ...interface{}) *RecorderAssertion {
+	a.t.Helper()
+	defer track(a.t, nil)()
+	if count := a.count(name); count != n {
+		str := fmt.Sprintf(`call count mismatch:
+   call: %q
+    got: %d
+ expect: %d
+  calls: %q`, name, count, n, a.events)
+		fail(a.t, str, msg...)
+	}
+	return a
+}
+
+// NoCall reports a test failure if the event name was recorded.
+func (a *RecorderAssertion) NoCall(name string, msg ...interface{}) *RecorderAssertion {
+	a.t.Helper()
+	defer track(a.t, nil)()
+	if count := a.count(name); count > 0 {
+		str := fmt.Sprintf(`unexpected call:
+   call: %q
+    got: %d call(s)
+  calls: %q`, name, count, a.events)
+		fail(a.t, str, msg...)
+	}
+	return a
+}
+
+// count returns the number of times the event name was recorded.
+func (a *RecorderAssertion) count(name string) int {
+	n := 0
+	for _, e := range a.events {
+		if e == name {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,75 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/internal/mock"
+)
+
+func TestRecorder(t *testing.T) {
+	var rec assert.Recorder
+	for _, e := range []string{"open", "write", "write", "flush", "write", "close"} {
+		rec.Record(e)
+	}
+
+	runCase(t, func(g *mock.MockT) {
+		assert.ThatRecorder(g, &rec).
+			CallsInOrder("open", "write", "close").
+			CallsInOrder().
+			CallCount("write", 3).
+			NoCall("delete")
+	})
+
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`calls not in order:
+    got: ["open" "write" "write" "flush" "write" "close"]
+ expect: ["open" "close" "flush"]
+missing: "flush" after ["open" "close"]`})
+		g.EXPECT().Error([]interface{}{`call count mismatch:
+   call: "write"
+    got: 3
+ expect: 2
+  calls: ["open" "write" "write" "flush" "write" "close"]
+message: buffered`})
+		g.EXPECT().Error([]interface{}{`unexpected call:
+   call: "flush"
+    got: 1 call(s)
+  calls: ["open" "write" "write" "flush" "write" "close"]`})
+		assert.ThatRecorder(assert.New(g).WithContinueChains(true), &rec).
+			CallsInOrder("open", "close", "flush").
+			CallCount("write", 2, "buffered").
+			NoCall("flush")
+	})
+
+	rec.Reset()
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			rec.Record("write")
+		}()
+	}
+	wg.Wait()
+	runCase(t, func(g *mock.MockT) {
+		assert.ThatRecorder(g, &rec).CallCount("write", 10)
+	})
+}
@@ -0,0 +1,81 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"container/list"
+	"regexp"
+	"sync"
+)
+
+// regexpCacheSize bounds the number of compiled patterns kept by
+// compileRegexp.
+const regexpCacheSize = 256
+
+// regexpCache holds the most recently used compiled patterns, so that
+// assertions matching the same pattern in a loop compile it only once.
+var regexpCache = struct {
+	sync.Mutex
+	l *list.List               // of *regexp.Regexp, most recent first
+	m map[string]*list.Element // by pattern
+}{l: list.New(), m: make(map[string]*list.Element)}
+
+// compileRegexp is like regexp.Compile, but caches compiled patterns.
+func compileRegexp(expr string) (*regexp.Regexp, error) {
+	regexpCache.Lock()
+	if e, ok := regexpCache.m[expr]; ok {
+		regexpCache.l.MoveToFront(e)
+		regexpCache.Unlock()
+		return e.Value.(*regexp.Regexp), nil
+	}
+	regexpCache.Unlock()
+
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	regexpCache.Lock()
+	defer regexpCache.Unlock()
+	if _, ok := regexpCache.m[expr]; !ok {
+		regexpCache.m[expr] = regexpCache.l.PushFront(re)
+		if regexpCache.l.Len() > regexpCacheSize {
+			e := regexpCache.l.Back()
+			regexpCache.l.Remove(e)
+			delete(regexpCache.m, e.Value.(*regexp.Regexp).String())
+		}
+	}
+	return re, nil
+}
+
+// matchString is like regexp.MatchString, but caches compiled patterns.
+func matchString(expr string, s string) (bool, error) {
+	re, err := compileRegexp(expr)
+	if err != nil {
+		return false, err
+	}
+	return re.MatchString(s), nil
+}
+
+// match is like regexp.Match, but caches compiled patterns.
+func match(expr string, b []byte) (bool, error) {
+	re, err := compileRegexp(expr)
+	if err != nil {
+		return false, err
+	}
+	return re.Match(b), nil
+}
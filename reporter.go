@@ -0,0 +1,222 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/lvan100/go-assert/internal"
+)
+
+// Reporter receives assertion failures.
+type Reporter interface {
+	Report(t internal.T, f Failure)
+}
+
+// ReporterFunc adapts a function to a Reporter, e.g. to forward failures to
+// a test-results service.
+type ReporterFunc func(t internal.T, f Failure)
+
+// Report implements Reporter.
+func (fn ReporterFunc) Report(t internal.T, f Failure) {
+	fn(t, f)
+}
+
+// SetReporter sets Settings.Reporters to r alone, or to none if r is nil,
+// and returns a function that restores the previous reporters.
+func SetReporter(r Reporter) (restore func()) {
+	return Configure(func(s *Settings) {
+		s.Reporters = nil
+		if r != nil {
+			s.Reporters = []Reporter{r}
+		}
+	})
+}
+
+// reportersOf returns the reporters of failures reported through t: the
+// package-level ones followed by those of the Asserters wrapping t.
+func reportersOf(t internal.T) []Reporter {
+	reporters := currentSettings().Reporters
+	for _, a := range asserterOf(t) {
+		reporters = append(reporters[:len(reporters):len(reporters)], a.reporters...)
+	}
+	return reporters
+}
+
+// TAPReporter writes assertion failures as "not ok" test points of the Test
+// Anything Protocol, version 13, with the failure output in a YAML block.
+// It is safe for concurrent use.
+type TAPReporter struct {
+	mu sync.Mutex
+	w  io.Writer
+	n  int
+}
+
+// NewTAPReporter returns a TAPReporter writing to w.
+func NewTAPReporter(w io.Writer) *TAPReporter {
+	return &TAPReporter{w: w}
+}
+
+// Report implements Reporter.
+func (r *TAPReporter) Report(t internal.T, f Failure) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.n++
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "not ok %d - %s\n  ---\n", r.n, tapEscape(failureName(t.Name(), f.Op)))
+	fmt.Fprintf(&sb, "  operation: %q\n", f.Op)
+	sb.WriteString("  message: |\n")
+	for _, line := range strings.Split(f.Output, "\n") {
+		sb.WriteString("    " + line + "\n")
+	}
+	sb.WriteString("  ...\n")
+	_, _ = io.WriteString(r.w, sb.String())
+}
+
+// Plan writes the TAP plan line for the failures reported so far.
+func (r *TAPReporter) Plan() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	_, _ = fmt.Fprintf(r.w, "1..%d\n", r.n)
+}
+
+// tapEscape escapes the characters that have a meaning in a TAP description.
+func tapEscape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "#", `\#`, "\n", " ").Replace(s)
+}
+
+// failureName names a failure by its test and operation.
+func failureName(test, op string) string {
+	if test == "" {
+		return op
+	}
+	return test + ": " + op
+}
+
+// JUnitReporter writes each assertion failure as a JUnit XML <testcase>
+// element with a <failure> child, which tools consuming JUnit reports can
+// merge into a <testsuite>. It is safe for concurrent use.
+type JUnitReporter struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewJUnitReporter returns a JUnitReporter writing to w.
+func NewJUnitReporter(w io.Writer) *JUnitReporter {
+	return &JUnitReporter{w: w}
+}
+
+type junitTestCase struct {
+	XMLName   xml.Name     `xml:"testcase"`
+	Name      string       `xml:"name,attr"`
+	ClassName string       `xml:"classname,attr,omitempty"`
+	Failure   junitFailure `xml:"failure"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// Report implements Reporter.
+func (r *JUnitReporter) Report(t internal.T, f Failure) {
+	test := t.Name()
+	if test == "" {
+		test = f.Op
+	}
+	message, _, _ := strings.Cut(f.Output, "\n")
+	b, err := xml.MarshalIndent(junitTestCase{
+		Name:      test,
+		ClassName: f.Op,
+		Failure: junitFailure{
+			Message: message,
+			Type:    f.Op,
+			Text:    f.Output,
+		},
+	}, "", "  ")
+	if err != nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	_, _ = r.w.Write(append(b, '\n'))
+}
+
+// JSONReporter writes each assertion failure as a JSON object on its own
+// line (JSON Lines), for CI tooling aggregating failures across packages.
+// It is safe for concurrent use.
+type JSONReporter struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewJSONReporter returns a JSONReporter writing to w.
+func NewJSONReporter(w io.Writer) *JSONReporter {
+	return &JSONReporter{w: w}
+}
+
+// jsonFailure is the record written by JSONReporter. Got and Expect are the
+// compared values with their types, e.g. `(string) "a"`, or the labeled
+// lines of the failure text if the assertion compares no values.
+type jsonFailure struct {
+	Test     string   `json:"test,omitempty"`
+	Op       string   `json:"op"`
+	Got      string   `json:"got,omitempty"`
+	Expect   string   `json:"expect,omitempty"`
+	Message  string   `json:"message,omitempty"`
+	Context  []string `json:"context,omitempty"`
+	Location string   `json:"location,omitempty"`
+	Output   string   `json:"output"`
+}
+
+// Report implements Reporter.
+func (r *JSONReporter) Report(t internal.T, f Failure) {
+	got, expect := f.Fields["got"], f.Fields["expect"]
+	if f.Got != nil || f.Expect != nil {
+		got, expect = typedValue(t, f.Got), typedValue(t, f.Expect)
+	}
+	b, err := json.Marshal(jsonFailure{
+		Test:     t.Name(),
+		Op:       f.Op,
+		Got:      got,
+		Expect:   expect,
+		Message:  f.Message,
+		Context:  f.Context,
+		Location: f.Caller,
+		Output:   f.Output,
+	})
+	if err != nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	_, _ = r.w.Write(append(b, '\n'))
+}
+
+// typedValue formats v with its type, as the failure texts do.
+func typedValue(t internal.T, v interface{}) string {
+	if s, ok := v.(string); ok {
+		return fmt.Sprintf("(%T) %q", s, s)
+	}
+	return fmt.Sprintf("(%T) %v", v, show(t, v))
+}
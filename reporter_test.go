@@ -0,0 +1,146 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/internal/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTAPReporter(t *testing.T) {
+	var buf bytes.Buffer
+	r := assert.NewTAPReporter(&buf)
+	defer assert.Configure(func(s *assert.Settings) {
+		s.Reporters = []assert.Reporter{r}
+	})()
+	runNamedCase(t, "TestUser/#1", func(g *namedT) {
+		g.EXPECT().Error(gomock.Any()).Times(2)
+		assert.ThatString(g, "a").Equal("b", "name")
+		assert.True(g, false)
+	})
+	r.Plan()
+	assert.ThatString(t, buf.String()).Equal(`not ok 1 - TestUser/\#1: StringAssertion.Equal
+  ---
+  operation: "StringAssertion.Equal"
+  message: |
+    strings not equal:
+        got: (string) "a"
+     expect: (string) "b"
+    message: name
+  ...
+not ok 2 - TestUser/\#1: True
+  ---
+  operation: "True"
+  message: |
+    got false but expect true
+  ...
+1..2
+`)
+}
+
+func TestJUnitReporter(t *testing.T) {
+	var buf bytes.Buffer
+	defer assert.Configure(func(s *assert.Settings) {
+		s.Reporters = []assert.Reporter{assert.NewJUnitReporter(&buf)}
+	})()
+	runNamedCase(t, "TestUser", func(g *namedT) {
+		g.EXPECT().Error(gomock.Any())
+		assert.ThatString(g, "a<").Equal("b")
+	})
+	assert.ThatString(t, buf.String()).Equal(`<testcase name="TestUser" classname="StringAssertion.Equal">
+  <failure message="strings not equal:" type="StringAssertion.Equal">strings not equal:&#xA;    got: (string) &#34;a&lt;&#34;&#xA; expect: (string) &#34;b&#34;</failure>
+</testcase>
+`)
+}
+
+func TestSetReporter(t *testing.T) {
+	var ops []string
+	record := func(prefix string) assert.Reporter {
+		return assert.ReporterFunc(func(t assert.T, f assert.Failure) {
+			ops = append(ops, prefix+f.Op)
+		})
+	}
+	restore := assert.SetReporter(record("global:"))
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error(gomock.Any()).Times(2)
+		assert.True(g, false)
+		a := assert.New(g).WithReporter(record("asserter:"))
+		assert.ThatNumber(a, 1).Equal(2)
+	})
+	restore()
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error(gomock.Any())
+		assert.False(g, true)
+	})
+	assert.ThatSlice(t, ops).Equal([]string{"global:True", "global:NumberAssertion.Equal", "asserter:NumberAssertion.Equal"})
+
+	defer assert.SetReporter(nil)()
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error(gomock.Any())
+		assert.True(g, false)
+	})
+	assert.ThatNumber(t, len(ops)).Equal(3)
+}
+
+func TestJSONReporter(t *testing.T) {
+	var buf bytes.Buffer
+	defer assert.SetReporter(assert.NewJSONReporter(&buf))()
+	runNamedCase(t, "TestUser", func(g *namedT) {
+		g.EXPECT().Error(gomock.Any())
+		assert.ThatString(assert.New(g).WithContext("login"), "a").Equal("b", "name")
+	})
+	assert.ThatString(t, buf.String()).Equal(`{"test":"TestUser","op":"StringAssertion.Equal","got":"(string) \"a\"","expect":"(string) \"b\"","message":"name","context":["login"],"location":"reporter_test.go:110","output":"login: strings not equal:\n    got: (string) \"a\"\n expect: (string) \"b\"\nmessage: name"}
+`)
+}
+
+func TestJSONReporter_Values(t *testing.T) {
+	var buf bytes.Buffer
+	defer assert.SetReporter(assert.NewJSONReporter(&buf))()
+	type record struct {
+		Got    string `json:"got"`
+		Expect string `json:"expect"`
+	}
+	last := func() (r record) {
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		assert.Nil(t, json.Unmarshal([]byte(lines[len(lines)-1]), &r))
+		return
+	}
+
+	runNamedCase(t, "TestUser", func(g *namedT) {
+		g.EXPECT().Error(gomock.Any())
+		assert.That(g, 1).Equal(2)
+	})
+	assert.That(t, last()).Equal(record{Got: "(int) 1", Expect: "(int) 2"})
+
+	runNamedCase(t, "TestUser", func(g *namedT) {
+		g.EXPECT().Error(gomock.Any())
+		assert.ThatMap(g, map[string]int{"a": 1}).Equal(map[string]int{"a": 2})
+	})
+	assert.That(t, last()).Equal(record{Got: "(map[string]int) map[a:1]", Expect: "(map[string]int) map[a:2]"})
+
+	runNamedCase(t, "TestUser", func(g *namedT) {
+		g.EXPECT().Error(gomock.Any())
+		assert.True(g, false)
+	})
+	assert.That(t, last()).Equal(record{Got: "(bool) false", Expect: "(bool) true"})
+}
@@ -0,0 +1,190 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/lvan100/go-assert/internal"
+)
+
+// RequestAssertion encapsulates an HTTP request and a test handler for
+// making assertions on it, typically a request captured by a fake
+// downstream server or a client middleware. Like ResponseAssertion, the
+// body is read at most once and req.Body is restored afterwards.
+type RequestAssertion struct {
+	t       internal.T
+	req     *http.Request
+	body    []byte
+	bodyErr error
+	read    bool
+}
+
+// ThatRequest returns a RequestAssertion for the given testing object and request.
+func ThatRequest(t internal.T, req *http.Request) *RequestAssertion {
+	return &RequestAssertion{
+		t:   chain(t),
+		req: req,
+	}
+}
+
+// valid reports a test failure if there is no request to assert on.
+func (a *RequestAssertion) valid(msg ...interface{}) bool {
+	a.t.Helper()
+	if a.req == nil {
+		fail(a.t, "expect not nil request", msg...)
+		return false
+	}
+	return true
+}
+
+// readBody reads and caches the request body, restoring req.Body.
+func (a *RequestAssertion) readBody(msg ...interface{}) ([]byte, bool) {
+	a.t.Helper()
+	if !a.valid(msg...) {
+		return nil, false
+	}
+	if !a.read {
+		a.read = true
+		a.body, a.req.Body, a.bodyErr = readAndRestore(a.req.Body)
+	}
+	if a.bodyErr != nil {
+		str := fmt.Sprintf(`unable to read request body:
+  error: %v`, a.bodyErr)
+		fail(a.t, str, msg...)
+		return nil, false
+	}
+	return a.body, true
+}
+
+// MethodIs reports a test failure if the request method is not equal to the expected method.
+func (a *RequestAssertion) MethodIs(method string, msg ...interface{}) *RequestAssertion {
+	a.t.Helper()
+	defer track(a.t, nil)()
+	if !a.valid(msg...) {
+		return a
+	}
+	if a.req.Method != method {
+		str := fmt.Sprintf(`request method mismatch:
+    got: %s
+ expect: %s`, a.req.Method, method)
+		fail(a.t, str, msg...)
+	}
+	return a
+}
+
+// PathIs reports a test failure if the request URL path is not equal to the expected path.
+func (a *RequestAssertion) PathIs(path string, msg ...interface{}) *RequestAssertion {
+	a.t.Helper()
+	defer track(a.t, nil)()
+	if !a.valid(msg...) {
+		return a
+	}
+	if got := a.req.URL.Path; got != path {
+		str := fmt.Sprintf(`request path mismatch:
+    got: %q
+ expect: %q`, show(a.t, got), path)
+		fail(a.t, str, msg...)
+	}
+	return a
+}
+
+// PathMatches reports a test failure if the request URL path does not match the given regular expression.
+func (a *RequestAssertion) PathMatches(expr string, msg ...interface{}) *RequestAssertion {
+	a.t.Helper()
+	defer track(a.t, nil)()
+	if !a.valid(msg...) {
+		return a
+	}
+	got := a.req.URL.Path
+	if ok, err := matchString(expr, got); !ok {
+		str := fmt.Sprintf(`request path does not match the pattern:
+    got: %q
+ expect: to match regex %q`, show(a.t, got), expr)
+		if err != nil {
+			str += fmt.Sprintf("\n  error: %v", err)
+		}
+		fail(a.t, str, msg...)
+	}
+	return a
+}
+
+// QueryParam returns a StringAssertion over the first value of the query
+// parameter name. A test failure is reported if the parameter is absent.
+func (a *RequestAssertion) QueryParam(name string, msg ...interface{}) *StringAssertion {
+	a.t.Helper()
+	defer track(a.t, nil)()
+	if !a.valid(msg...) {
+		return ThatString(a.t, "")
+	}
+	return ThatURL(a.t, a.req.URL).QueryParam(name, msg...)
+}
+
+// RequestURL returns a URLAssertion over the request URL.
+func (a *RequestAssertion) RequestURL(msg ...interface{}) *URLAssertion {
+	a.t.Helper()
+	if !a.valid(msg...) {
+		return &URLAssertion{t: a.t}
+	}
+	return ThatURL(a.t, a.req.URL)
+}
+
+// HeaderContains reports a test failure if no value of the request header
+// key contains the substring.
+func (a *RequestAssertion) HeaderContains(key string, substr string, msg ...interface{}) *RequestAssertion {
+	a.t.Helper()
+	defer track(a.t, nil)()
+	if !a.valid(msg...) {
+		return a
+	}
+	values := a.req.Header.Values(key)
+	for _, v := range values {
+		if strings.Contains(v, substr) {
+			return a
+		}
+	}
+	str := fmt.Sprintf(`header does not contain the specified substring:
+ header: %q
+    got: %q
+ expect: to contain substring %q`, http.CanonicalHeaderKey(key), values, show(a.t, substr))
+	fail(a.t, str, msg...)
+	return a
+}
+
+// Header returns a HeaderAssertion over the request headers.
+func (a *RequestAssertion) Header(msg ...interface{}) *HeaderAssertion {
+	a.t.Helper()
+	if !a.valid(msg...) {
+		return ThatHeader(a.t, nil)
+	}
+	return ThatHeader(a.t, a.req.Header)
+}
+
+// BodyJSONEqual reports a test failure if the request body and the expected
+// string are not equivalent JSON documents.
+func (a *RequestAssertion) BodyJSONEqual(expect string, msg ...interface{}) *RequestAssertion {
+	a.t.Helper()
+	defer track(a.t, nil)()
+	b, ok := a.readBody(msg...)
+	if !ok {
+		return a
+	}
+	bodyJSONEqual(a.t, "request", b, expect, "", msg...)
+	return a
+}
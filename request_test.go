@@ -0,0 +1,86 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/internal/mock"
+)
+
+func TestRequest(t *testing.T) {
+	newReq := func() *http.Request {
+		r := httptest.NewRequest(http.MethodPost, "/users/42/orders?page=2", strings.NewReader(`{"qty": 1}`))
+		r.Header.Set("Accept", "application/json, text/plain")
+		return r
+	}
+
+	runCase(t, func(g *mock.MockT) {
+		req := newReq()
+		assert.ThatRequest(g, req).
+			MethodIs(http.MethodPost).
+			PathIs("/users/42/orders").
+			PathMatches(`^/users/\d+/orders$`).
+			HeaderContains("accept", "text/plain").
+			BodyJSONEqual(`{"qty": 1}`)
+		assert.ThatRequest(g, req).QueryParam("page").Equal("2")
+
+		// the body is restored and can still be read after the assertions
+		b, _ := io.ReadAll(req.Body)
+		assert.ThatString(g, string(b)).Equal(`{"qty": 1}`)
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`request method mismatch:
+    got: POST
+ expect: GET`})
+		g.EXPECT().Error([]interface{}{`request path mismatch:
+    got: "/users/42/orders"
+ expect: "/users"`})
+		g.EXPECT().Error([]interface{}{`request path does not match the pattern:
+    got: "/users/42/orders"
+ expect: to match regex "^/items"`})
+		assert.ThatRequest(g, newReq()).MethodIs(http.MethodGet)
+		assert.ThatRequest(g, newReq()).PathIs("/users")
+		assert.ThatRequest(g, newReq()).PathMatches("^/items")
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`query parameter not found:
+  param: "size"
+    got: "page=2"`})
+		// checks on a missing parameter are skipped
+		assert.ThatRequest(g, newReq()).QueryParam("size").Equal("10")
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`header does not contain the specified substring:
+ header: "Accept"
+    got: ["application/json, text/plain"]
+ expect: to contain substring "xml"`})
+		g.EXPECT().Error([]interface{}{`request body JSON structures are not equal:
+    got: (string) "{\"qty\": 1}"
+ expect: (string) "{\"qty\": 2}"`})
+		assert.ThatRequest(assert.New(g).WithContinueChains(true), newReq()).HeaderContains("Accept", "xml").BodyJSONEqual(`{"qty": 2}`)
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"expect not nil request"})
+		assert.ThatRequest(g, nil).MethodIs(http.MethodGet)
+	})
+}
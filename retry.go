@@ -0,0 +1,189 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strings"
+	"time"
+
+	"github.com/lvan100/go-assert/internal"
+)
+
+// Collector is a test handler that records the failures of the assertions
+// made through it instead of reporting them, see Retry.
+type Collector struct {
+	t        internal.T
+	failures []string
+}
+
+// collectorStop is the panic value unwinding an attempt stopped by Fatal.
+type collectorStop struct{}
+
+// Helper marks the calling function as a test helper function.
+func (c *Collector) Helper() {
+	c.t.Helper()
+}
+
+// Error records a failure.
+func (c *Collector) Error(args ...interface{}) {
+	c.failures = append(c.failures, fmt.Sprint(args...))
+}
+
+// Errorf is like Error with a formatted message.
+func (c *Collector) Errorf(format string, args ...interface{}) {
+	c.Error(fmt.Sprintf(format, args...))
+}
+
+// Fatal records a failure and stops the attempt.
+func (c *Collector) Fatal(args ...interface{}) {
+	c.Error(args...)
+	panic(collectorStop{})
+}
+
+// Fatalf is like Fatal with a formatted message.
+func (c *Collector) Fatalf(format string, args ...interface{}) {
+	c.Fatal(fmt.Sprintf(format, args...))
+}
+
+// Logf logs a formatted message through the wrapped test handler.
+func (c *Collector) Logf(format string, args ...interface{}) {
+	c.t.Helper()
+	c.t.Logf(format, args...)
+}
+
+// Name returns the name of the test.
+func (c *Collector) Name() string {
+	return c.t.Name()
+}
+
+// Cleanup registers fn to run when the test finishes.
+func (c *Collector) Cleanup(fn func()) {
+	c.t.Cleanup(fn)
+}
+
+// Skip skips the test through the wrapped test handler.
+func (c *Collector) Skip(args ...interface{}) {
+	c.t.Helper()
+	c.t.Skip(args...)
+}
+
+// Unwrap returns the wrapped test handler.
+func (c *Collector) Unwrap() internal.T {
+	return c.t
+}
+
+// Plain implements internal.Plain, as the failures recorded are colored
+// once reported.
+func (c *Collector) Plain() {}
+
+// Collected implements internal.Collected, as the failures recorded are
+// reported by Retry once it gives up.
+func (c *Collector) Collected() {}
+
+// Failed reports whether an assertion failed.
+func (c *Collector) Failed() bool {
+	return len(c.failures) > 0
+}
+
+// Failures returns the failure messages recorded.
+func (c *Collector) Failures() []string {
+	return c.failures
+}
+
+// run runs fn with c, stopping at the first Fatal.
+func (c *Collector) run(fn func(c *Collector)) {
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(collectorStop); !ok {
+				panic(r)
+			}
+		}
+	}()
+	fn(c)
+}
+
+// Backoff returns the delay before the given retry, counting from 1.
+type Backoff func(retry int) time.Duration
+
+// ConstantBackoff returns a Backoff waiting d before every retry.
+func ConstantBackoff(d time.Duration) Backoff {
+	return func(int) time.Duration {
+		return d
+	}
+}
+
+// ExponentialBackoff returns a Backoff waiting initial before the first
+// retry and twice as long before each of the next ones, up to max.
+func ExponentialBackoff(initial, max time.Duration) Backoff {
+	return func(retry int) time.Duration {
+		d := initial
+		for i := 1; i < retry && d < max; i++ {
+			d *= 2
+		}
+		return min(d, max)
+	}
+}
+
+// WithJitter returns a Backoff that shortens or lengthens each delay of b
+// by a random amount of up to the given fraction of it, so that retries of
+// concurrent tests spread out.
+func WithJitter(b Backoff, fraction float64) Backoff {
+	return func(retry int) time.Duration {
+		d := b(retry)
+		return d + time.Duration((rand.Float64()*2-1)*fraction*float64(d))
+	}
+}
+
+// Retry runs fn until all the assertions it makes through its Collector
+// pass, at most the given number of attempts, waiting as given by backoff
+// before each retry. If they still fail after the last attempt, it reports
+// the failures of that attempt. Unlike polling a condition, it retries a
+// whole block of assertions, which suits checks of flaky external
+// dependencies:
+//
+//	assert.Retry(t, 5, assert.ExponentialBackoff(100*time.Millisecond, time.Second), func(c *assert.Collector) {
+//		resp, err := http.Get(url)
+//		assert.ThatError(c, err).IsNil()
+//		assert.ThatResponse(c, resp).StatusIs(http.StatusOK)
+//	})
+//
+// An assertion failing with Fatal, e.g. in fail-fast mode, ends its attempt.
+func Retry(t internal.T, attempts int, backoff Backoff, fn func(c *Collector), msg ...interface{}) bool {
+	t.Helper()
+	defer track(t, nil)()
+	attempts = max(attempts, 1)
+	var c *Collector
+	for i := 1; i <= attempts; i++ {
+		if i > 1 && backoff != nil {
+			time.Sleep(backoff(i - 1))
+		}
+		c = &Collector{t: t}
+		c.run(fn)
+		if !c.Failed() {
+			return true
+		}
+	}
+	noun := "attempts"
+	if attempts == 1 {
+		noun = "attempt"
+	}
+	str := fmt.Sprintf("assertions still failing after %d %s:\n%s", attempts, noun, strings.Join(c.failures, "\n"))
+	fail(t, str, msg...)
+	return false
+}
@@ -0,0 +1,77 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/internal/mock"
+)
+
+func TestRetry(t *testing.T) {
+	runCase(t, func(g *mock.MockT) {
+		n := 0
+		assert.Retry(g, 3, assert.ConstantBackoff(time.Millisecond), func(c *assert.Collector) {
+			n++
+			assert.That(c, n).Equal(3)
+		})
+		assert.That(t, n).Equal(3)
+	})
+	runCase(t, func(g *mock.MockT) {
+		n := 0
+		g.EXPECT().Error([]interface{}{`assertions still failing after 2 attempts:
+got (int) 2 but expect (int) 3
+got false but expect true
+message: service ready`})
+		assert.Retry(g, 2, nil, func(c *assert.Collector) {
+			n++
+			assert.That(c, n).Equal(3)
+			assert.True(c, false)
+		}, "service ready")
+	})
+	runCase(t, func(g *mock.MockT) {
+		// a fatal failure ends the attempt
+		g.EXPECT().Error([]interface{}{`assertions still failing after 1 attempt:
+got false but expect true`})
+		assert.Retry(g, 0, nil, func(c *assert.Collector) {
+			assert.True(assert.New(c).WithFailFast(true), false)
+			assert.True(c, false)
+		})
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`assertions still failing after 1 attempt:
+not ready: 1`})
+		assert.Retry(g, 0, nil, func(c *assert.Collector) {
+			c.Errorf("not ready: %d", 1)
+		})
+	})
+}
+
+func TestBackoff(t *testing.T) {
+	b := assert.ExponentialBackoff(100*time.Millisecond, time.Second)
+	assert.That(t, b(1)).Equal(100 * time.Millisecond)
+	assert.That(t, b(3)).Equal(400 * time.Millisecond)
+	assert.That(t, b(10)).Equal(time.Second)
+
+	j := assert.WithJitter(assert.ConstantBackoff(time.Second), 0.1)
+	for range 100 {
+		d := j(1)
+		assert.True(t, d >= 900*time.Millisecond && d <= 1100*time.Millisecond)
+	}
+}
@@ -0,0 +1,120 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/lvan100/go-assert/internal"
+)
+
+// Codec marshals values to bytes and back, see RoundTrips.
+type Codec interface {
+	Name() string
+	Marshal(v interface{}) ([]byte, error)
+	Unmarshal(data []byte, v interface{}) error
+}
+
+// funcCodec is a Codec made of functions.
+type funcCodec struct {
+	name      string
+	marshal   func(v interface{}) ([]byte, error)
+	unmarshal func(data []byte, v interface{}) error
+}
+
+func (c funcCodec) Name() string                               { return c.name }
+func (c funcCodec) Marshal(v interface{}) ([]byte, error)      { return c.marshal(v) }
+func (c funcCodec) Unmarshal(data []byte, v interface{}) error { return c.unmarshal(data, v) }
+
+// NewCodec returns a Codec with the given name, shown in failure messages,
+// built from a pair of marshal and unmarshal functions.
+func NewCodec(name string, marshal func(v interface{}) ([]byte, error), unmarshal func(data []byte, v interface{}) error) Codec {
+	return funcCodec{name: name, marshal: marshal, unmarshal: unmarshal}
+}
+
+// Built-in codecs for RoundTrips.
+var (
+	JSONCodec = NewCodec("json", json.Marshal, json.Unmarshal)
+	GobCodec  = NewCodec("gob", gobMarshal, gobUnmarshal)
+)
+
+func gobMarshal(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func gobUnmarshal(data []byte, v interface{}) error {
+	return gob.NewDecoder(bytes.NewReader(data)).Decode(v)
+}
+
+// RoundTrips asserts that value survives a marshal and unmarshal through
+// codec: decoding the encoded value into a fresh value of the same type
+// must yield a deeply equal value. On failure every field that was lost
+// (decoded as zero) or mutated is listed, which catches missing struct
+// tags, unexported fields and lossy custom marshalers.
+func RoundTrips(t internal.T, value interface{}, codec Codec, msg ...interface{}) bool {
+	t.Helper()
+	defer track(t, value)()
+	data, err := codec.Marshal(value)
+	if err != nil {
+		str := fmt.Sprintf(`unable to marshal value:
+  codec: %s
+    got: (%T) %v
+  error: %v`, codec.Name(), value, value, err)
+		fail(t, str, msg...)
+		return false
+	}
+	typ := reflect.TypeOf(value)
+	if typ == nil {
+		fail(t, "expect not nil value", msg...)
+		return false
+	}
+	ptr := reflect.New(typ)
+	if err = codec.Unmarshal(data, ptr.Interface()); err != nil {
+		str := fmt.Sprintf(`unable to unmarshal value:
+  codec: %s
+   data: %q
+  error: %v`, codec.Name(), data, err)
+		fail(t, str, msg...)
+		return false
+	}
+	diffs := newDeepCompare().compareAll(ptr.Elem().Interface(), value)
+	if len(diffs) == 0 {
+		return true
+	}
+	rows := [][]string{{"path", "change", "original", "decoded"}}
+	for _, d := range diffs {
+		change := "mutated"
+		if !d.got.IsValid() || d.got.IsZero() {
+			change = "lost"
+		}
+		rows = append(rows, []string{displayPath(d.path), change, d.expectString(), d.gotString()})
+	}
+	str := fmt.Sprintf(`value does not survive a %s round trip:
+   type: %s
+   data: %q
+%s`, codec.Name(), typ, data, renderTable(rows))
+	fail(t, str, msg...)
+	return false
+}
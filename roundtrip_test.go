@@ -0,0 +1,83 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/internal/mock"
+	"github.com/lvan100/go-assert/yamlassert"
+)
+
+type Event struct {
+	Name   string            `json:"name" yaml:"name"`
+	Count  int               `json:"count" yaml:"count"`
+	Labels map[string]string `json:"labels" yaml:"labels"`
+	Secret string            `json:"-" yaml:"-"`
+	Level  Level             `json:"level" yaml:"level"`
+}
+
+// Level marshals to JSON lossily, in upper case.
+type Level string
+
+func (l Level) MarshalJSON() ([]byte, error) {
+	return json.Marshal(strings.ToUpper(string(l)))
+}
+
+func TestRoundTrips(t *testing.T) {
+	e := Event{Name: "deploy", Count: 2, Labels: map[string]string{"env": "prod"}}
+
+	runCase(t, func(g *mock.MockT) {
+		assert.RoundTrips(g, e, assert.JSONCodec)
+		assert.RoundTrips(g, e, yamlassert.Codec)
+		assert.RoundTrips(g, e, assert.GobCodec)
+		assert.RoundTrips(g, []int{1, 2}, assert.JSONCodec)
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`value does not survive a json round trip:
+   type: assert_test.Event
+   data: "{\"name\":\"deploy\",\"count\":2,\"labels\":{\"env\":\"prod\"},\"level\":\"WARN\"}"
+    path   | change  | original | decoded
+    Secret | lost    | s3cr3t   | 
+    Level  | mutated | warn     | WARN`})
+		v := e
+		v.Secret = "s3cr3t"
+		v.Level = "warn"
+		assert.RoundTrips(g, v, assert.JSONCodec)
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`unable to marshal value:
+  codec: json
+    got: (chan int) <nil>
+  error: json: unsupported type: chan int
+message: channels`})
+		assert.RoundTrips(g, (chan int)(nil), assert.JSONCodec, "channels")
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`unable to unmarshal value:
+  codec: broken
+   data: "[]"
+  error: json: cannot unmarshal array into Go value of type assert_test.Event`})
+		broken := assert.NewCodec("broken", func(v interface{}) ([]byte, error) {
+			return []byte("[]"), nil
+		}, json.Unmarshal)
+		assert.RoundTrips(g, e, broken)
+	})
+}
@@ -0,0 +1,164 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"sync"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/lvan100/go-assert/internal"
+)
+
+// Settings is the package-level configuration shared by all assertions.
+// Use Configure to change it.
+type Settings struct {
+	// Color controls ANSI coloring of failure messages.
+	Color ColorMode
+
+	// Verbosity controls how much of large values is printed in failure
+	// messages. It can be overridden per Asserter, see WithVerbosity.
+	Verbosity Verbosity
+
+	// StackTrace appends the stack of the failing assertion to failure
+	// messages, leaving out frames of this package. It can be overridden
+	// per Asserter, see WithStackTrace.
+	StackTrace bool
+
+	// ArtifactsDir is the directory failure artifacts are written to. When
+	// a compared value, or the failure text of an assertion without one,
+	// is larger than ArtifactThreshold bytes, the full got and expect
+	// values and their diff are written to files under it, and the failure
+	// message references them. If empty, the TEST_ARTIFACTS environment
+	// variable is used; if that is not set either, no files are written.
+	ArtifactsDir string
+
+	// ArtifactThreshold is the size in bytes above which artifacts are
+	// written. Zero means TruncatedValueLen.
+	ArtifactThreshold int
+
+	// Reporters receive every failure right before it is reported to the
+	// test, e.g. to mirror failures into TAP or JUnit XML for tools that
+	// do not read Go test output, see NewTAPReporter and NewJUnitReporter.
+	Reporters []Reporter
+
+	// LogPasses logs every passing assertion with its condensed subject
+	// value through the Logf method of the test handler, which shows how
+	// far a hanging or flaky test progressed when run with -v. It can be
+	// overridden per Asserter, see WithLogPasses.
+	LogPasses bool
+
+	// FailFast makes every failing assertion stop the test with Fatal
+	// instead of reporting an error and continuing. It can be overridden
+	// per Asserter, see WithFailFast.
+	FailFast bool
+
+	// ContinueChains keeps running the checks of a chain, such as
+	// ThatString(t, s).HasPrefix("a").HasSuffix("b"), after one failed.
+	// By default they are skipped, as they are usually meaningless then,
+	// including later checks of the same assertion object. It can be
+	// overridden per Asserter, see WithContinueChains.
+	ContinueChains bool
+
+	// DiffContext is the number of unchanged lines printed around each
+	// hunk of the line diffs of failure messages that are too long to be
+	// printed whole. Zero means 3; negative means none.
+	DiffContext int
+
+	// MaxDiffs is the number of differences printed in failure messages,
+	// counting the hunks of long line diffs and the rows of difference
+	// tables; the others are summarized as "…and N more differences".
+	// Zero means 10; negative means no limit.
+	MaxDiffs int
+
+	// CmpOptions makes deep comparisons use github.com/google/go-cmp with
+	// these options instead of reflect.DeepEqual, e.g. to ignore
+	// unexported fields with cmpopts.IgnoreUnexported or to compare floats
+	// approximately with cmpopts.EquateApprox. It applies to That(...).Equal
+	// and NotEqual, to the Equal and NotEqual methods of slices and maps,
+	// and to decoded JSON and YAML content and struct fields. It can be
+	// overridden per Asserter, see WithCmpOptions.
+	CmpOptions []cmp.Option
+
+	// CallerLocation prefixes failure messages with the "file.go:123: "
+	// location of the assertion call site, the first caller outside this
+	// package, for when the attribution of t.Helper is wrong or missing,
+	// e.g. for assertions made in helpers that do not call t.Helper. It
+	// can be overridden per Asserter, see WithCallerLocation.
+	CallerLocation bool
+}
+
+var (
+	settingsMu sync.RWMutex
+	settings   Settings
+)
+
+// Configure applies fn to the package-level settings and returns a function
+// that restores the previous settings, e.g. for use with defer or t.Cleanup:
+//
+//	defer assert.Configure(func(s *assert.Settings) {
+//		s.Color = assert.ColorNever
+//	})()
+func Configure(fn func(s *Settings)) (restore func()) {
+	settingsMu.Lock()
+	defer settingsMu.Unlock()
+	prev := settings
+	fn(&settings)
+	return func() {
+		settingsMu.Lock()
+		defer settingsMu.Unlock()
+		settings = prev
+	}
+}
+
+// SetFailFast sets Settings.FailFast and returns a function that restores
+// the previous value.
+func SetFailFast(enabled bool) (restore func()) {
+	return Configure(func(s *Settings) {
+		s.FailFast = enabled
+	})
+}
+
+// callerLocationOf reports whether failures reported through t are prefixed
+// with the location of the call site: as set by the outermost Asserter
+// setting it, or else by the package-level setting.
+func callerLocationOf(t internal.T) bool {
+	for _, a := range asserterOf(t) {
+		if a.callerLocation != nil {
+			return *a.callerLocation
+		}
+	}
+	return currentSettings().CallerLocation
+}
+
+// failFastOf reports whether failures reported through t stop the test: as
+// set by the outermost Asserter setting it, or else by the package-level
+// setting.
+func failFastOf(t internal.T) bool {
+	for _, a := range asserterOf(t) {
+		if a.failFast != nil {
+			return *a.failFast
+		}
+	}
+	return currentSettings().FailFast
+}
+
+// currentSettings returns a copy of the package-level settings.
+func currentSettings() Settings {
+	settingsMu.RLock()
+	defer settingsMu.RUnlock()
+	return settings
+}
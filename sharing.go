@@ -0,0 +1,125 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/lvan100/go-assert/internal"
+)
+
+// memRegion is a range of memory reachable from a value through a pointer,
+// a slice backing array or a map.
+type memRegion struct {
+	start, end uintptr // [start, end)
+	kind       string
+	path       string
+}
+
+// NoSharedPointers asserts that a and b share no memory reachable through
+// pointers, slice backing arrays or maps, which is what a correct Clone or
+// DeepCopy implementation must guarantee and what DeepEqual cannot check.
+// Zero-sized regions, such as empty slices, are not considered shared.
+// It reports an error listing every shared location.
+func NoSharedPointers(t internal.T, a, b interface{}, msg ...interface{}) bool {
+	t.Helper()
+	defer track(t, nil)()
+	regions := collectRegions(reflect.ValueOf(a))
+	var shared []string
+	for _, r := range collectRegions(reflect.ValueOf(b)) {
+		for _, o := range regions {
+			if r.start < o.end && o.start < r.end {
+				shared = append(shared, fmt.Sprintf("%s <-> %s (%s)", displayPath(o.path), displayPath(r.path), r.kind))
+				break
+			}
+		}
+	}
+	if len(shared) > 0 {
+		str := fmt.Sprintf(`values share memory:
+   type: (%T), (%T)
+ shared: %s`, a, b, strings.Join(shared, "\n         "))
+		fail(t, str, msg...)
+		return false
+	}
+	return true
+}
+
+// collectRegions returns the memory regions reachable from v.
+func collectRegions(v reflect.Value) []memRegion {
+	var out []memRegion
+	walkRegions(v, "", map[memRegion]bool{}, &out)
+	return out
+}
+
+func walkRegions(v reflect.Value, path string, seen map[memRegion]bool, out *[]memRegion) {
+	if !v.IsValid() {
+		return
+	}
+	add := func(start uintptr, size uintptr, kind string) bool {
+		if start == 0 || size == 0 {
+			return true
+		}
+		r := memRegion{start: start, end: start + size, kind: kind}
+		if seen[r] {
+			return false
+		}
+		seen[r] = true
+		r.path = path
+		*out = append(*out, r)
+		return true
+	}
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() || !add(v.Pointer(), v.Type().Elem().Size(), "pointer") {
+			return
+		}
+		walkRegions(v.Elem(), path, seen, out)
+	case reflect.Interface:
+		if !v.IsNil() {
+			walkRegions(v.Elem(), path, seen, out)
+		}
+	case reflect.Slice:
+		if v.IsNil() || !add(v.Pointer(), uintptr(v.Cap())*v.Type().Elem().Size(), "slice") {
+			return
+		}
+		for i := 0; i < v.Len(); i++ {
+			walkRegions(v.Index(i), fmt.Sprintf("%s[%d]", path, i), seen, out)
+		}
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			walkRegions(v.Index(i), fmt.Sprintf("%s[%d]", path, i), seen, out)
+		}
+	case reflect.Map:
+		if v.IsNil() || !add(v.Pointer(), 1, "map") {
+			return
+		}
+		iter := v.MapRange()
+		for iter.Next() {
+			walkRegions(iter.Value(), fmt.Sprintf("%s[%v]", path, iter.Key()), seen, out)
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			name := v.Type().Field(i).Name
+			if path != "" {
+				name = path + "." + name
+			}
+			walkRegions(v.Field(i), name, seen, out)
+		}
+	}
+}
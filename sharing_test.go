@@ -0,0 +1,79 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert_test
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/internal/mock"
+)
+
+type Doc struct {
+	Title string
+	Owner *User
+	Tags  []string
+	Attrs map[string]string
+}
+
+func (d *Doc) DeepCopy() *Doc {
+	c := *d
+	if d.Owner != nil {
+		o := *d.Owner
+		c.Owner = &o
+	}
+	c.Tags = slices.Clone(d.Tags)
+	c.Attrs = maps.Clone(d.Attrs)
+	return &c
+}
+
+func (d *Doc) ShallowCopy() *Doc {
+	c := *d
+	return &c
+}
+
+func TestNoSharedPointers(t *testing.T) {
+	d := &Doc{
+		Title: "spec",
+		Owner: &User{Name: "bob"},
+		Tags:  []string{"a", "b"},
+		Attrs: map[string]string{"k": "v"},
+	}
+
+	runCase(t, func(g *mock.MockT) {
+		assert.NoSharedPointers(g, d, d.DeepCopy())
+		assert.NoSharedPointers(g, Doc{}, Doc{})
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`values share memory:
+   type: (*assert_test.Doc), (*assert_test.Doc)
+ shared: Owner <-> Owner (pointer)
+         Tags <-> Tags (slice)
+         Attrs <-> Attrs (map)
+message: ShallowCopy`})
+		assert.NoSharedPointers(g, d, d.ShallowCopy(), "ShallowCopy")
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`values share memory:
+   type: ([]int), ([]int)
+ shared: (root) <-> (root) (slice)`})
+		s := []int{1, 2, 3, 4}
+		assert.NoSharedPointers(g, s[:2], s[2:])
+	})
+}
@@ -36,339 +36,426 @@ func ThatSlice[T cmp.Ordered](t internal.T, v []T) *SliceAssertion[T] {
 }
 
 // Len asserts that the slice has the expected length.
-func (a *SliceAssertion[T]) Len(length int, msg ...string) {
+func (a *SliceAssertion[T]) Len(length int, msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	if len(a.v) != length {
 		str := fmt.Sprintf("got length %d but expect length %d", len(a.v), length)
 		fail(a.t, str, msg...)
+		return false
 	}
+	return true
 }
 
 // IsEmpty asserts that the slice is empty.
-func (a *SliceAssertion[T]) IsEmpty(msg ...string) {
+func (a *SliceAssertion[T]) IsEmpty(msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	if len(a.v) != 0 {
-		str := fmt.Sprintf("got %v is not empty", a.v)
+		str := fmt.Sprintf("got %v is not empty", show(a.t, a.v))
 		fail(a.t, str, msg...)
+		return false
 	}
+	return true
 }
 
 // IsNotEmpty asserts that the slice is not empty.
-func (a *SliceAssertion[T]) IsNotEmpty(msg ...string) {
+func (a *SliceAssertion[T]) IsNotEmpty(msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	if len(a.v) == 0 {
-		str := fmt.Sprintf("got %v is empty", a.v)
+		str := fmt.Sprintf("got %v is empty", show(a.t, a.v))
 		fail(a.t, str, msg...)
+		return false
 	}
+	return true
 }
 
 // IsNil asserts that the slice is nil.
-func (a *SliceAssertion[T]) IsNil(msg ...string) {
+func (a *SliceAssertion[T]) IsNil(msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	if a.v != nil {
-		str := fmt.Sprintf("got %v is not nil", a.v)
+		str := fmt.Sprintf("got %v is not nil", show(a.t, a.v))
 		fail(a.t, str, msg...)
+		return false
 	}
+	return true
 }
 
 // IsNotNil asserts that the slice is not nil.
-func (a *SliceAssertion[T]) IsNotNil(msg ...string) {
+func (a *SliceAssertion[T]) IsNotNil(msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	if a.v == nil {
-		str := fmt.Sprintf("got %v is nil", a.v)
+		str := fmt.Sprintf("got %v is nil", show(a.t, a.v))
 		fail(a.t, str, msg...)
+		return false
 	}
+	return true
 }
 
 // Zero asserts that the slice is nil or empty.
-func (a *SliceAssertion[T]) Zero(msg ...string) {
+func (a *SliceAssertion[T]) Zero(msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	if a.v != nil && len(a.v) != 0 {
-		str := fmt.Sprintf("got %v is not nil or empty", a.v)
+		str := fmt.Sprintf("got %v is not nil or empty", show(a.t, a.v))
 		fail(a.t, str, msg...)
+		return false
 	}
+	return true
 }
 
 // NotZero asserts that the slice is not nil and not empty.
-func (a *SliceAssertion[T]) NotZero(msg ...string) {
+func (a *SliceAssertion[T]) NotZero(msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	if a.v == nil || len(a.v) == 0 {
-		str := fmt.Sprintf("got %v is nil or empty", a.v)
+		str := fmt.Sprintf("got %v is nil or empty", show(a.t, a.v))
 		fail(a.t, str, msg...)
+		return false
 	}
+	return true
 }
 
 // Contains asserts that the slice contains the expected element.
-func (a *SliceAssertion[T]) Contains(element T, msg ...string) {
+func (a *SliceAssertion[T]) Contains(element T, msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	for _, v := range a.v {
 		if v == element {
-			return
+			return true
 		}
 	}
-	str := fmt.Sprintf("got %v does not contain %v", a.v, element)
+	str := fmt.Sprintf("got %v does not contain %v", show(a.t, a.v), show(a.t, element))
 	fail(a.t, str, msg...)
+	return false
 }
 
 // NotContains asserts that the slice does not contain the expected element.
-func (a *SliceAssertion[T]) NotContains(element T, msg ...string) {
+func (a *SliceAssertion[T]) NotContains(element T, msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	for _, v := range a.v {
 		if v == element {
-			str := fmt.Sprintf("got %v contains %v", a.v, element)
+			str := fmt.Sprintf("got %v contains %v", show(a.t, a.v), show(a.t, element))
 			fail(a.t, str, msg...)
-			return
+			return false
 		}
 	}
+	return true
 }
 
 // SubSlice asserts that the slice contains the expected sub-slice.
-func (a *SliceAssertion[T]) SubSlice(sub []T, msg ...string) {
+func (a *SliceAssertion[T]) SubSlice(sub []T, msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	if len(sub) == 0 {
-		return
+		return true
 	}
-	for i := 0; i <= len(a.v)-len(sub); i++ {
-		match := true
-		for j := 0; j < len(sub); j++ {
-			if a.v[i+j] != sub[j] {
-				match = false
-				break
-			}
-		}
-		if match {
-			return
-		}
+	if indexSlice(a.v, sub) >= 0 {
+		return true
 	}
-	str := fmt.Sprintf("got %v does not contain sub-slice %v", a.v, sub)
+	str := fmt.Sprintf("got %v does not contain sub-slice %v", show(a.t, a.v), show(a.t, sub))
 	fail(a.t, str, msg...)
+	return false
 }
 
 // NotSubSlice asserts that the slice does not contain the expected sub-slice.
-func (a *SliceAssertion[T]) NotSubSlice(sub []T, msg ...string) {
+func (a *SliceAssertion[T]) NotSubSlice(sub []T, msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	if len(sub) == 0 {
-		return
+		return true
 	}
-	for i := 0; i <= len(a.v)-len(sub); i++ {
-		match := true
-		for j := 0; j < len(sub); j++ {
-			if a.v[i+j] != sub[j] {
-				match = false
-				break
-			}
+	if indexSlice(a.v, sub) >= 0 {
+		str := fmt.Sprintf("got %v contains sub-slice %v", show(a.t, a.v), show(a.t, sub))
+		fail(a.t, str, msg...)
+		return false
+	}
+	return true
+}
+
+// indexSlice returns the index of the first occurrence of sub in s, or -1.
+// It runs the Knuth-Morris-Pratt algorithm, in time linear in len(s) and
+// len(sub).
+func indexSlice[T comparable](s, sub []T) int {
+	if len(sub) == 0 {
+		return 0
+	}
+	// next[j] is the length of the longest proper prefix of sub[:j+1] that
+	// is also a suffix of it.
+	next := make([]int, len(sub))
+	for j, k := 1, 0; j < len(sub); j++ {
+		for k > 0 && sub[j] != sub[k] {
+			k = next[k-1]
 		}
-		if match {
-			str := fmt.Sprintf("got %v contains sub-slice %v", a.v, sub)
-			fail(a.t, str, msg...)
-			return
+		if sub[j] == sub[k] {
+			k++
 		}
+		next[j] = k
 	}
+	for i, k := 0, 0; i < len(s); i++ {
+		for k > 0 && s[i] != sub[k] {
+			k = next[k-1]
+		}
+		if s[i] == sub[k] {
+			k++
+		}
+		if k == len(sub) {
+			return i - k + 1
+		}
+	}
+	return -1
 }
 
 // HasPrefix asserts that the slice starts with the specified prefix.
-func (a *SliceAssertion[T]) HasPrefix(prefix []T, msg ...string) {
+func (a *SliceAssertion[T]) HasPrefix(prefix []T, msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	if len(prefix) > len(a.v) {
 		str := fmt.Sprintf("got length %d is less than prefix length %d", len(a.v), len(prefix))
 		fail(a.t, str, msg...)
-		return
+		return false
 	}
 	for i := range prefix {
 		if a.v[i] != prefix[i] {
-			str := fmt.Sprintf("got element %v at index %d does not match prefix element %v", a.v[i], i, prefix[i])
+			str := fmt.Sprintf("got element %v at index %d does not match prefix element %v", show(a.t, a.v[i]), i, show(a.t, prefix[i]))
 			fail(a.t, str, msg...)
-			return
+			return false
 		}
 	}
+	return true
 }
 
 // HasSuffix asserts that the slice ends with the specified suffix.
-func (a *SliceAssertion[T]) HasSuffix(suffix []T, msg ...string) {
+func (a *SliceAssertion[T]) HasSuffix(suffix []T, msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	if len(suffix) > len(a.v) {
 		str := fmt.Sprintf("got length %d is less than suffix length %d", len(a.v), len(suffix))
 		fail(a.t, str, msg...)
-		return
+		return false
 	}
 	offset := len(a.v) - len(suffix)
 	for i := range suffix {
 		if a.v[offset+i] != suffix[i] {
-			str := fmt.Sprintf("got element %v at index %d does not match suffix element %v", a.v[offset+i], offset+i, suffix[i])
+			str := fmt.Sprintf("got element %v at index %d does not match suffix element %v", show(a.t, a.v[offset+i]), offset+i, show(a.t, suffix[i]))
 			fail(a.t, str, msg...)
-			return
+			return false
 		}
 	}
+	return true
 }
 
 // Equal asserts that the slice is equal to the expected slice.
-func (a *SliceAssertion[T]) Equal(expect []T, msg ...string) {
+func (a *SliceAssertion[T]) Equal(expect []T, msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	if len(a.v) != len(expect) {
 		str := fmt.Sprintf("got length %d but expect length %d", len(a.v), len(expect))
-		fail(a.t, str, msg...)
-		return
+		failValues(a.t, a.v, expect, str, msg...)
+		return false
 	}
-	for i := range a.v {
-		if a.v[i] != expect[i] {
-			str := fmt.Sprintf("got element %v at index %d but expect %v", a.v[i], i, expect[i])
-			fail(a.t, str, msg...)
-			return
-		}
+	eq := elementsEqual[T](a.t)
+	if i := firstMismatch(a.v, expect, eq); i >= 0 {
+		str := fmt.Sprintf("got element %v at index %d but expect %v", show(a.t, a.v[i]), i, show(a.t, expect[i]))
+		failValues(a.t, a.v, expect, str, msg...)
+		return false
 	}
+	return true
 }
 
 // NotEqual asserts that the slice is not equal to the expected slice.
-func (a *SliceAssertion[T]) NotEqual(expect []T, msg ...string) {
+func (a *SliceAssertion[T]) NotEqual(expect []T, msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	if len(a.v) == len(expect) {
+		eq := elementsEqual[T](a.t)
 		equal := true
 		for i := range a.v {
-			if a.v[i] != expect[i] {
+			if !eq(a.v[i], expect[i]) {
 				equal = false
 				break
 			}
 		}
 		if equal {
-			str := fmt.Sprintf("got %v but expect not %v", a.v, expect)
-			fail(a.t, str, msg...)
+			str := fmt.Sprintf("got %v but expect not %v", show(a.t, a.v), show(a.t, expect))
+			failValues(a.t, a.v, expect, str, msg...)
+			return false
 		}
 	}
+	return true
 }
 
 // IsIncreasing asserts that the slice is strictly increasing.
-func (a *SliceAssertion[T]) IsIncreasing(msg ...string) {
+func (a *SliceAssertion[T]) IsIncreasing(msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	for i := 1; i < len(a.v); i++ {
 		if a.v[i-1] >= a.v[i] {
-			str := fmt.Sprintf("got element %v at index %d is not greater than %v at index %d", a.v[i], i, a.v[i-1], i-1)
+			str := fmt.Sprintf("got element %v at index %d is not greater than %v at index %d", show(a.t, a.v[i]), i, show(a.t, a.v[i-1]), i-1)
 			fail(a.t, str, msg...)
-			return
+			return false
 		}
 	}
+	return true
 }
 
 // IsNonIncreasing asserts that the slice is not strictly increasing.
-func (a *SliceAssertion[T]) IsNonIncreasing(msg ...string) {
+func (a *SliceAssertion[T]) IsNonIncreasing(msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	for i := 1; i < len(a.v); i++ {
 		if a.v[i-1] < a.v[i] {
-			str := fmt.Sprintf("got element %v at index %d is greater than %v at index %d", a.v[i], i, a.v[i-1], i-1)
+			str := fmt.Sprintf("got element %v at index %d is greater than %v at index %d", show(a.t, a.v[i]), i, show(a.t, a.v[i-1]), i-1)
 			fail(a.t, str, msg...)
-			return
+			return false
 		}
 	}
+	return true
 }
 
 // IsDecreasing asserts that the slice is strictly decreasing.
-func (a *SliceAssertion[T]) IsDecreasing(msg ...string) {
+func (a *SliceAssertion[T]) IsDecreasing(msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	for i := 1; i < len(a.v); i++ {
 		if a.v[i-1] <= a.v[i] {
-			str := fmt.Sprintf("got element %v at index %d is not less than %v at index %d", a.v[i], i, a.v[i-1], i-1)
+			str := fmt.Sprintf("got element %v at index %d is not less than %v at index %d", show(a.t, a.v[i]), i, show(a.t, a.v[i-1]), i-1)
 			fail(a.t, str, msg...)
-			return
+			return false
 		}
 	}
+	return true
 }
 
 // IsNonDecreasing asserts that the slice is not strictly decreasing.
-func (a *SliceAssertion[T]) IsNonDecreasing(msg ...string) {
+func (a *SliceAssertion[T]) IsNonDecreasing(msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	for i := 1; i < len(a.v); i++ {
 		if a.v[i-1] > a.v[i] {
-			str := fmt.Sprintf("got element %v at index %d is less than %v at index %d", a.v[i], i, a.v[i-1], i-1)
+			str := fmt.Sprintf("got element %v at index %d is less than %v at index %d", show(a.t, a.v[i]), i, show(a.t, a.v[i-1]), i-1)
 			fail(a.t, str, msg...)
-			return
+			return false
 		}
 	}
+	return true
 }
 
 // IsSorted asserts that the slice is sorted in ascending order.
-func (a *SliceAssertion[T]) IsSorted(msg ...string) {
+func (a *SliceAssertion[T]) IsSorted(msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	for i := 1; i < len(a.v); i++ {
 		if a.v[i-1] > a.v[i] {
-			str := fmt.Sprintf("got element %v at index %d is greater than %v at index %d", a.v[i], i, a.v[i-1], i-1)
+			str := fmt.Sprintf("got element %v at index %d is greater than %v at index %d", show(a.t, a.v[i]), i, show(a.t, a.v[i-1]), i-1)
 			fail(a.t, str, msg...)
-			return
+			return false
 		}
 	}
+	return true
 }
 
 // IsSortedDescending asserts that the slice is sorted in descending order.
-func (a *SliceAssertion[T]) IsSortedDescending(msg ...string) {
+func (a *SliceAssertion[T]) IsSortedDescending(msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	for i := 1; i < len(a.v); i++ {
 		if a.v[i-1] < a.v[i] {
-			str := fmt.Sprintf("got element %v at index %d is less than %v at index %d", a.v[i], i, a.v[i-1], i-1)
+			str := fmt.Sprintf("got element %v at index %d is less than %v at index %d", show(a.t, a.v[i]), i, show(a.t, a.v[i-1]), i-1)
 			fail(a.t, str, msg...)
-			return
+			return false
 		}
 	}
+	return true
 }
 
 // IsUnique asserts that all elements in the slice are unique.
-func (a *SliceAssertion[T]) IsUnique(msg ...string) {
+func (a *SliceAssertion[T]) IsUnique(msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	seen := make(map[T]bool)
 	for _, v := range a.v {
 		if seen[v] {
-			str := fmt.Sprintf("got duplicate element %v", v)
+			str := fmt.Sprintf("got duplicate element %v", show(a.t, v))
 			fail(a.t, str, msg...)
-			return
+			return false
 		}
 		seen[v] = true
 	}
+	return true
 }
 
 // IsUniqueBy asserts that all elements in the slice are unique based on a custom function.
-func (a *SliceAssertion[T]) IsUniqueBy(fn func(T) interface{}, msg ...string) {
+func (a *SliceAssertion[T]) IsUniqueBy(fn func(T) interface{}, msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	seen := make(map[interface{}]bool)
 	for _, v := range a.v {
 		key := fn(v)
 		if seen[key] {
-			str := fmt.Sprintf("got duplicate element %v", v)
+			str := fmt.Sprintf("got duplicate element %v", show(a.t, v))
 			fail(a.t, str, msg...)
-			return
+			return false
 		}
 		seen[key] = true
 	}
+	return true
 }
 
 // All asserts that all elements in the slice satisfy the given condition.
-func (a *SliceAssertion[T]) All(fn func(T) bool, msg ...string) {
+func (a *SliceAssertion[T]) All(fn func(T) bool, msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	for _, v := range a.v {
 		if !fn(v) {
-			str := fmt.Sprintf("got element %v does not satisfy the condition", v)
+			str := fmt.Sprintf("got element %v does not satisfy the condition", show(a.t, v))
 			fail(a.t, str, msg...)
-			return
+			return false
 		}
 	}
+	return true
 }
 
 // Any asserts that at least one element in the slice satisfies the given condition.
-func (a *SliceAssertion[T]) Any(fn func(T) bool, msg ...string) {
+func (a *SliceAssertion[T]) Any(fn func(T) bool, msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	for _, v := range a.v {
 		if fn(v) {
-			return
+			return true
 		}
 	}
-	str := fmt.Sprintf("no element in %v satisfies the condition", a.v)
+	str := fmt.Sprintf("no element in %v satisfies the condition", show(a.t, a.v))
 	fail(a.t, str, msg...)
+	return false
 }
 
 // None asserts that no element in the slice satisfies the given condition.
-func (a *SliceAssertion[T]) None(fn func(T) bool, msg ...string) {
+func (a *SliceAssertion[T]) None(fn func(T) bool, msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
 	for _, v := range a.v {
 		if fn(v) {
-			str := fmt.Sprintf("got element %v satisfies the condition", v)
+			str := fmt.Sprintf("got element %v satisfies the condition", show(a.t, v))
 			fail(a.t, str, msg...)
-			return
+			return false
+		}
+	}
+	return true
+}
+
+// firstMismatch returns the index of the first element of got that differs
+// from the one in expect as compared by eq, or -1 if there is none. got and
+// expect have the same length.
+func firstMismatch[T any](got, expect []T, eq func(x, y T) bool) int {
+	for i := range got {
+		if !eq(got[i], expect[i]) {
+			return i
 		}
 	}
+	return -1
 }
@@ -15,3 +15,63 @@
  */
 
 package assert_test
+
+import (
+	"testing"
+
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/internal/mock"
+)
+
+func TestSlice_SubSlice(t *testing.T) {
+	runCase(t, func(g *mock.MockT) {
+		assert.ThatSlice(g, []int{1, 2, 3}).SubSlice(nil)
+		assert.ThatSlice(g, []int{1, 2, 3}).SubSlice([]int{2, 3})
+		assert.ThatSlice(g, []int{1, 1, 2, 1, 1, 1, 2}).SubSlice([]int{1, 1, 1, 2})
+		assert.ThatSlice(g, []string{"a", "b", "a", "b", "c"}).SubSlice([]string{"a", "b", "c"})
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"got [1 2 1 2] does not contain sub-slice [1 2 2]"})
+		assert.ThatSlice(g, []int{1, 2, 1, 2}).SubSlice([]int{1, 2, 2})
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"got [1] does not contain sub-slice [1 2]\nmessage: param (index=0)"})
+		assert.ThatSlice(g, []int{1}).SubSlice([]int{1, 2}, "param (index=0)")
+	})
+}
+
+func TestSlice_NotSubSlice(t *testing.T) {
+	runCase(t, func(g *mock.MockT) {
+		assert.ThatSlice(g, []int{1, 2, 1, 2}).NotSubSlice([]int{2, 2})
+		assert.ThatSlice(g, []int{1}).NotSubSlice([]int{1, 1})
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"got [1 2 1 2 3] contains sub-slice [1 2 3]"})
+		assert.ThatSlice(g, []int{1, 2, 1, 2, 3}).NotSubSlice([]int{1, 2, 3})
+	})
+}
+
+func TestSlice_Equal(t *testing.T) {
+	runCase(t, func(g *mock.MockT) {
+		assert.ThatSlice(g, []int{1, 2}).Equal([]int{1, 2})
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"got length 1 but expect length 2"})
+		assert.ThatSlice(g, []int{1}).Equal([]int{1, 2})
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"got element 3 at index 1 but expect 2"})
+		assert.ThatSlice(g, []int{1, 3, 4}).Equal([]int{1, 2, 3})
+	})
+}
+
+func BenchmarkSlice_SubSlice(b *testing.B) {
+	// a worst case for naive search: many near-matches of a long sub-slice
+	v := make([]int, 200_000)
+	sub := make([]int, 1_000)
+	sub[len(sub)-1] = 1
+	v[len(v)-1] = 1
+	for b.Loop() {
+		assert.ThatSlice(b, v).SubSlice(sub)
+	}
+}
@@ -0,0 +1,261 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/lvan100/go-assert/internal"
+)
+
+// LogRecord is a log record captured by a LogCapture, with the attributes
+// of the record and of its logger flattened into Attrs. Keys of attributes
+// in groups are qualified by the group names, joined with ".".
+type LogRecord struct {
+	Level   slog.Level
+	Message string
+	Attrs   []slog.Attr
+}
+
+// String returns the level, message and attributes of the record.
+func (r LogRecord) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s %q", r.Level, r.Message)
+	for _, a := range r.Attrs {
+		fmt.Fprintf(&sb, " %s=%v", a.Key, a.Value)
+	}
+	return sb.String()
+}
+
+// logStore holds the records of a LogCapture and of the handlers derived
+// from it.
+type logStore struct {
+	mu      sync.Mutex
+	records []LogRecord
+}
+
+// LogCapture is a slog.Handler recording every log record it handles, at
+// every level, for assertions with ThatLogs:
+//
+//	c := assert.NewLogCapture()
+//	svc := NewService(slog.New(c))
+//	svc.Do()
+//	assert.ThatLogs(t, c).HasRecord(slog.LevelInfo, "done").AttrEqual("user_id", 42)
+//
+// It is safe for concurrent use.
+type LogCapture struct {
+	store  *logStore
+	attrs  []slog.Attr
+	prefix string
+}
+
+// NewLogCapture returns an empty LogCapture.
+func NewLogCapture() *LogCapture {
+	return &LogCapture{store: new(logStore)}
+}
+
+// Enabled implements slog.Handler and reports true for every level.
+func (c *LogCapture) Enabled(context.Context, slog.Level) bool {
+	return true
+}
+
+// Handle implements slog.Handler.
+func (c *LogCapture) Handle(_ context.Context, r slog.Record) error {
+	attrs := append([]slog.Attr{}, c.attrs...)
+	r.Attrs(func(a slog.Attr) bool {
+		attrs = appendAttr(attrs, c.prefix, a)
+		return true
+	})
+	c.store.mu.Lock()
+	defer c.store.mu.Unlock()
+	c.store.records = append(c.store.records, LogRecord{
+		Level:   r.Level,
+		Message: r.Message,
+		Attrs:   attrs,
+	})
+	return nil
+}
+
+// WithAttrs implements slog.Handler.
+func (c *LogCapture) WithAttrs(attrs []slog.Attr) slog.Handler {
+	d := *c
+	d.attrs = append([]slog.Attr{}, c.attrs...)
+	for _, a := range attrs {
+		d.attrs = appendAttr(d.attrs, c.prefix, a)
+	}
+	return &d
+}
+
+// WithGroup implements slog.Handler.
+func (c *LogCapture) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return c
+	}
+	d := *c
+	d.prefix = c.prefix + name + "."
+	return &d
+}
+
+// Records returns the records captured so far, in order.
+func (c *LogCapture) Records() []LogRecord {
+	c.store.mu.Lock()
+	defer c.store.mu.Unlock()
+	return append([]LogRecord{}, c.store.records...)
+}
+
+// Reset discards the records captured so far.
+func (c *LogCapture) Reset() {
+	c.store.mu.Lock()
+	defer c.store.mu.Unlock()
+	c.store.records = nil
+}
+
+// appendAttr appends a to attrs with its key qualified by prefix, resolving
+// its value and flattening groups.
+func appendAttr(attrs []slog.Attr, prefix string, a slog.Attr) []slog.Attr {
+	v := a.Value.Resolve()
+	if v.Kind() == slog.KindGroup {
+		if a.Key != "" {
+			prefix += a.Key + "."
+		}
+		for _, g := range v.Group() {
+			attrs = appendAttr(attrs, prefix, g)
+		}
+		return attrs
+	}
+	if a.Key == "" {
+		return attrs
+	}
+	return append(attrs, slog.Attr{Key: prefix + a.Key, Value: v})
+}
+
+// LogsAssertion encapsulates the records of a LogCapture and a test handler
+// for making assertions on them.
+type LogsAssertion struct {
+	t       internal.T
+	records []LogRecord
+}
+
+// ThatLogs returns a LogsAssertion for the given testing object and the
+// records captured so far by c.
+func ThatLogs(t internal.T, c *LogCapture) *LogsAssertion {
+	return &LogsAssertion{
+		t:       chain(t),
+		records: c.Records(),
+	}
+}
+
+// describe returns the captured records, one per line, indented to follow
+// a message label.
+func (a *LogsAssertion) describe() string {
+	if len(a.records) == 0 {
+		return "no records"
+	}
+	lines := make([]string, len(a.records))
+	for i, r := range a.records {
+		lines[i] = r.String()
+	}
+	return strings.Join(lines, "\n         ")
+}
+
+// HasRecord reports a test failure if no record at the given level has a
+// message containing msgContains.
+func (a *LogsAssertion) HasRecord(level slog.Level, msgContains string, msg ...interface{}) *LogsAssertion {
+	a.t.Helper()
+	defer track(a.t, nil)()
+	for _, r := range a.records {
+		if r.Level == level && strings.Contains(r.Message, msgContains) {
+			return a
+		}
+	}
+	str := fmt.Sprintf(`log record not found:
+ expect: %s record containing %q
+    got: %s`, level, msgContains, a.describe())
+	fail(a.t, str, msg...)
+	return a
+}
+
+// AttrEqual reports a test failure if no record has an attribute key with a
+// value equal to expect. Values are compared as slog values, so an int
+// expectation matches an attribute logged as int64.
+func (a *LogsAssertion) AttrEqual(key string, expect interface{}, msg ...interface{}) *LogsAssertion {
+	a.t.Helper()
+	defer track(a.t, nil)()
+	want := slog.AnyValue(expect).Resolve()
+	var got []string
+	for _, r := range a.records {
+		for _, attr := range r.Attrs {
+			if attr.Key != key {
+				continue
+			}
+			if logValueEqual(attr.Value, want) {
+				return a
+			}
+			got = append(got, attr.Value.String())
+		}
+	}
+	str := fmt.Sprintf(`log attribute mismatch:
+    key: %q
+    got: %s
+ expect: (%T) %v`, key, attrValues(got), expect, expect)
+	fail(a.t, str, msg...)
+	return a
+}
+
+// CountAtLevel reports a test failure if the number of records at the given
+// level is not n.
+func (a *LogsAssertion) CountAtLevel(level slog.Level, n int, msg ...interface{}) *LogsAssertion {
+	a.t.Helper()
+	defer track(a.t, nil)()
+	count := 0
+	for _, r := range a.records {
+		if r.Level == level {
+			count++
+		}
+	}
+	if count != n {
+		str := fmt.Sprintf(`log record count mismatch:
+  level: %s
+    got: %d
+ expect: %d
+records: %s`, level, count, n, a.describe())
+		fail(a.t, str, msg...)
+	}
+	return a
+}
+
+// attrValues describes the values found for an attribute key.
+func attrValues(values []string) string {
+	if len(values) == 0 {
+		return "not present"
+	}
+	return fmt.Sprintf("%q", values)
+}
+
+// logValueEqual reports whether the resolved slog values x and y are equal,
+// falling back to deep equality for values of kind Any.
+func logValueEqual(x, y slog.Value) bool {
+	if x.Kind() == slog.KindAny && y.Kind() == slog.KindAny {
+		return reflect.DeepEqual(x.Any(), y.Any())
+	}
+	return x.Kind() == y.Kind() && x.Equal(y)
+}
@@ -0,0 +1,76 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert_test
+
+import (
+	"log/slog"
+	"testing"
+
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/internal/mock"
+)
+
+func TestLogs(t *testing.T) {
+	c := assert.NewLogCapture()
+	logger := slog.New(c)
+	logger.Info("user created", "user_id", 42)
+	logger.With("req", "r1").WithGroup("db").Warn("slow query", slog.Group("q", "ms", 120))
+
+	runCase(t, func(g *mock.MockT) {
+		assert.ThatLogs(g, c).
+			HasRecord(slog.LevelInfo, "created").
+			AttrEqual("user_id", 42).
+			AttrEqual("req", "r1").
+			AttrEqual("db.q.ms", 120).
+			CountAtLevel(slog.LevelError, 0).
+			CountAtLevel(slog.LevelWarn, 1)
+	})
+
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`log record not found:
+ expect: ERROR record containing "boom"
+    got: INFO "user created" user_id=42
+         WARN "slow query" req=r1 db.q.ms=120`})
+		assert.ThatLogs(g, c).HasRecord(slog.LevelError, "boom")
+	})
+
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`log attribute mismatch:
+    key: "user_id"
+    got: ["42"]
+ expect: (int) 7`})
+		assert.ThatLogs(g, c).AttrEqual("user_id", 7)
+	})
+
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`log attribute mismatch:
+    key: "missing"
+    got: not present
+ expect: (string) x`})
+		assert.ThatLogs(g, c).AttrEqual("missing", "x")
+	})
+
+	c.Reset()
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`log record count mismatch:
+  level: INFO
+    got: 0
+ expect: 1
+records: no records`})
+		assert.ThatLogs(g, c).CountAtLevel(slog.LevelInfo, 1)
+	})
+}
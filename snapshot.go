@@ -0,0 +1,185 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/lvan100/go-assert/internal"
+)
+
+// SnapshotDir is the directory, relative to the package under test,
+// where snapshot files are stored.
+const SnapshotDir = "testdata/snapshots"
+
+// UpdateSnapshotsEnv is the environment variable that, when set to a true
+// value (e.g. "1" or "true"), makes MatchSnapshot record snapshots with the
+// current values instead of comparing against them.
+const UpdateSnapshotsEnv = "UPDATE_SNAPSHOTS"
+
+// Serializer converts a value into the textual form stored in a snapshot.
+type Serializer interface {
+	Serialize(v interface{}) (string, error)
+}
+
+// SerializerFunc adapts an ordinary function to the Serializer interface.
+type SerializerFunc func(v interface{}) (string, error)
+
+// Serialize calls f(v).
+func (f SerializerFunc) Serialize(v interface{}) (string, error) {
+	return f(v)
+}
+
+var (
+	// PrettySerializer renders values as indented Go-like literals.
+	// Strings are stored verbatim.
+	PrettySerializer Serializer = SerializerFunc(func(v interface{}) (string, error) {
+		if s, ok := v.(string); ok {
+			return s, nil
+		}
+		return prettyPrint(v), nil
+	})
+
+	// JSONSerializer renders values as indented JSON.
+	JSONSerializer Serializer = SerializerFunc(func(v interface{}) (string, error) {
+		b, err := json.MarshalIndent(v, "", "  ")
+		if err != nil {
+			return "", err
+		}
+		return string(b), nil
+	})
+)
+
+// snapshotCounters tracks how many snapshots each test has matched so far,
+// so that a test may call MatchSnapshot more than once.
+var snapshotCounters = struct {
+	sync.Mutex
+	m map[string]int
+}{m: make(map[string]int)}
+
+// MatchSnapshot serializes v with PrettySerializer and compares it against
+// the snapshot stored for the current test. See MatchSnapshotWith.
+func MatchSnapshot(t internal.T, v interface{}, msg ...interface{}) bool {
+	t.Helper()
+	defer track(t, v)()
+	return MatchSnapshotWith(t, v, PrettySerializer, msg...)
+}
+
+// MatchSnapshotWith serializes v with the given serializer and compares it
+// against the snapshot stored for the current test under SnapshotDir.
+// Snapshots are keyed by test name and call order within the test. When
+// UpdateSnapshotsEnv is set, snapshots are recorded, overwriting existing
+// ones. Otherwise a test failure is reported if the snapshot is missing, so
+// that a test cannot pass without its snapshot, e.g. in CI, or with a line
+// diff if the serialized value differs from the stored snapshot.
+// The test handler must provide a Name method, as *testing.T does.
+func MatchSnapshotWith(t internal.T, v interface{}, s Serializer, msg ...interface{}) bool {
+	t.Helper()
+	defer track(t, v)()
+	path := snapshotPath(t)
+	got, err := s.Serialize(v)
+	if err != nil {
+		str := fmt.Sprintf(`unable to serialize value:
+    got: (%T) %v
+  error: %v`, v, show(t, v), err)
+		fail(t, str, msg...)
+		return false
+	}
+
+	update, _ := strconv.ParseBool(os.Getenv(UpdateSnapshotsEnv))
+	if update {
+		if err = writeSnapshot(path, got); err != nil {
+			fail(t, "unable to write snapshot: "+err.Error(), msg...)
+			return false
+		}
+		return true
+	}
+	b, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		str := fmt.Sprintf(`snapshot not found:
+   path: %s
+set %s=1 to record the snapshot`, path, UpdateSnapshotsEnv)
+		fail(t, str, msg...)
+		return false
+	}
+	if err != nil {
+		fail(t, "unable to read snapshot: "+err.Error(), msg...)
+		return false
+	}
+
+	if expect := string(b); got != expect {
+		str := fmt.Sprintf(`snapshot mismatch:
+   path: %s
+   diff: (-snapshot +got)
+%s
+set %s=1 to update the snapshot`, path, windowedDiff(expect, got), UpdateSnapshotsEnv)
+		fail(t, str, msg...)
+		return false
+	}
+	return true
+}
+
+// snapshotPath returns the file that stores the next snapshot of the test.
+func snapshotPath(t internal.T) string {
+	name := t.Name()
+
+	snapshotCounters.Lock()
+	snapshotCounters.m[name]++
+	n := snapshotCounters.m[name]
+	snapshotCounters.Unlock()
+
+	// Reset the counter once the test finishes so that -count=N reruns
+	// compare against the same files.
+	if n == 1 {
+		t.Cleanup(func() {
+			snapshotCounters.Lock()
+			delete(snapshotCounters.m, name)
+			snapshotCounters.Unlock()
+		})
+	}
+
+	return filepath.Join(SnapshotDir, fmt.Sprintf("%s_%d.snap", snapshotFileName(name), n))
+}
+
+// snapshotFileName turns a test name into the base of a snapshot file name:
+// subtest separators become "__", spaces and colons "_", and other symbols
+// are dropped as testing.T.TempDir does, so that the name is valid on every
+// platform, e.g. on Windows, which rejects * ? " < > | in file names.
+func snapshotFileName(name string) string {
+	name = strings.NewReplacer("/", "__", " ", "_", ":", "_").Replace(name)
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsNumber(r) || strings.ContainsRune("!#$%&()+,-.=@^_{}~", r) {
+			return r
+		}
+		return -1
+	}, name)
+}
+
+func writeSnapshot(path string, content string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, []byte(content), 0o644)
+}
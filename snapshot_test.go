@@ -0,0 +1,226 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert_test
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/internal/mock"
+	"github.com/lvan100/go-assert/yamlassert"
+)
+
+// namedT adds Name and Cleanup methods to the mock so that snapshots can be keyed.
+type namedT struct {
+	*mock.MockT
+	name     string
+	cleanups []func()
+}
+
+func (t *namedT) Name() string { return t.name }
+
+func (t *namedT) Cleanup(fn func()) { t.cleanups = append(t.cleanups, fn) }
+
+func runNamedCase(t *testing.T, name string, f func(g *namedT)) {
+	runCase(t, func(m *mock.MockT) {
+		g := &namedT{MockT: m, name: name}
+		defer func() {
+			for _, fn := range g.cleanups {
+				fn()
+			}
+		}()
+		f(g)
+	})
+}
+
+type snapshotUser struct {
+	Name string            `json:"name" yaml:"name"`
+	Tags []string          `json:"tags" yaml:"tags"`
+	Meta map[string]string `json:"meta" yaml:"meta"`
+}
+
+func TestMatchSnapshot(t *testing.T) {
+	t.Chdir(t.TempDir())
+	user := snapshotUser{Name: "bob", Tags: []string{"a"}, Meta: map[string]string{"b": "2", "a": "1"}}
+
+	// missing snapshots fail unless they are being recorded
+	runNamedCase(t, "TestUser/pretty", func(g *namedT) {
+		g.EXPECT().Error([]interface{}{`snapshot not found:
+   path: testdata/snapshots/TestUser__pretty_1.snap
+set UPDATE_SNAPSHOTS=1 to record the snapshot`})
+		assert.MatchSnapshot(g, user)
+	})
+	_, err := os.Stat(assert.SnapshotDir)
+	assert.True(t, os.IsNotExist(err))
+
+	t.Setenv(assert.UpdateSnapshotsEnv, "1")
+	runNamedCase(t, "TestUser/pretty", func(g *namedT) {
+		assert.MatchSnapshot(g, user)
+	})
+	runNamedCase(t, "TestUser/json", func(g *namedT) {
+		assert.MatchSnapshotWith(g, user, assert.JSONSerializer)
+	})
+	runNamedCase(t, "TestUser/yaml", func(g *namedT) {
+		assert.MatchSnapshotWith(g, user, yamlassert.Serializer)
+		assert.MatchSnapshotWith(g, user.Tags, yamlassert.Serializer)
+	})
+	t.Setenv(assert.UpdateSnapshotsEnv, "")
+
+	b, err := os.ReadFile(filepath.Join(assert.SnapshotDir, "TestUser__pretty_1.snap"))
+	assert.Nil(t, err)
+	assert.ThatString(t, string(b)).Equal(`assert_test.snapshotUser{
+  Name: "bob",
+  Tags: []string{
+    "a",
+  },
+  Meta: map[string]string{
+    "a": "1",
+    "b": "2",
+  },
+}`)
+
+	b, err = os.ReadFile(filepath.Join(assert.SnapshotDir, "TestUser__yaml_1.snap"))
+	assert.Nil(t, err)
+	assert.ThatString(t, string(b)).Equal("name: bob\ntags:\n    - a\nmeta:\n    a: \"1\"\n    b: \"2\"\n")
+
+	b, err = os.ReadFile(filepath.Join(assert.SnapshotDir, "TestUser__yaml_2.snap"))
+	assert.Nil(t, err)
+	assert.ThatString(t, string(b)).Equal("- a\n")
+
+	runNamedCase(t, "TestUser/json", func(g *namedT) {
+		assert.MatchSnapshotWith(g, user, assert.JSONSerializer)
+	})
+
+	user.Tags = []string{"b"}
+	runNamedCase(t, "TestUser/json", func(g *namedT) {
+		g.EXPECT().Error([]interface{}{`snapshot mismatch:
+   path: testdata/snapshots/TestUser__json_1.snap
+   diff: (-snapshot +got)
+  {
+    "name": "bob",
+    "tags": [
+-     "a"
++     "b"
+    ],
+    "meta": {
+      "a": "1",
+      "b": "2"
+    }
+  }
+set UPDATE_SNAPSHOTS=1 to update the snapshot`})
+		assert.MatchSnapshotWith(g, user, assert.JSONSerializer)
+	})
+
+	t.Setenv(assert.UpdateSnapshotsEnv, "1")
+	runNamedCase(t, "TestUser/json", func(g *namedT) {
+		assert.MatchSnapshotWith(g, user, assert.JSONSerializer)
+	})
+	t.Setenv(assert.UpdateSnapshotsEnv, "")
+	runNamedCase(t, "TestUser/json", func(g *namedT) {
+		assert.MatchSnapshotWith(g, user, assert.JSONSerializer)
+	})
+}
+
+func TestMatchSnapshot_FileName(t *testing.T) {
+	t.Chdir(t.TempDir())
+	t.Setenv(assert.UpdateSnapshotsEnv, "1")
+	// symbols invalid in file names on some platforms are dropped
+	runNamedCase(t, `TestQuery/a<b>*?"|\c: d`, func(g *namedT) {
+		assert.MatchSnapshot(g, 1)
+	})
+	entries, err := os.ReadDir(assert.SnapshotDir)
+	assert.Nil(t, err)
+	assert.That(t, entries).HasLen(1)
+	assert.ThatString(t, entries[0].Name()).Equal("TestQuery__abc__d_1.snap")
+}
+
+func TestMatchSnapshot_SerializeError(t *testing.T) {
+	failing := assert.SerializerFunc(func(v interface{}) (string, error) {
+		return "", errors.New("boom")
+	})
+	runNamedCase(t, "TestSerialize", func(g *namedT) {
+		g.EXPECT().Error([]interface{}{"unable to serialize value:\n    got: (string) " +
+			strings.Repeat("x", 80) + "... (20 more bytes)\n  error: boom"})
+		a := assert.New(g).WithVerbosity(assert.VerbosityCompact)
+		assert.MatchSnapshotWith(a, strings.Repeat("x", 100), failing)
+	})
+}
+
+func TestMatchSnapshot_LongDiff(t *testing.T) {
+	t.Chdir(t.TempDir())
+	lines := make([]string, 100)
+	for i := range lines {
+		lines[i] = fmt.Sprintf("line %d", i)
+	}
+	t.Setenv(assert.UpdateSnapshotsEnv, "1")
+	runNamedCase(t, "TestLongDiff", func(g *namedT) {
+		assert.MatchSnapshot(g, strings.Join(lines, "\n"))
+	})
+	t.Setenv(assert.UpdateSnapshotsEnv, "")
+
+	lines[10], lines[80] = "LINE 10", "LINE 80"
+	runNamedCase(t, "TestLongDiff", func(g *namedT) {
+		g.EXPECT().Error([]interface{}{`snapshot mismatch:
+   path: testdata/snapshots/TestLongDiff_1.snap
+   diff: (-snapshot +got)
+  ...
+  line 7
+  line 8
+  line 9
+- line 10
++ LINE 10
+  line 11
+  line 12
+  line 13
+  ...
+  line 77
+  line 78
+  line 79
+- line 80
++ LINE 80
+  line 81
+  line 82
+  line 83
+  ...
+set UPDATE_SNAPSHOTS=1 to update the snapshot`})
+		assert.MatchSnapshot(g, strings.Join(lines, "\n"))
+	})
+
+	defer assert.Configure(func(s *assert.Settings) {
+		s.DiffContext = 1
+		s.MaxDiffs = 1
+	})()
+	runNamedCase(t, "TestLongDiff", func(g *namedT) {
+		g.EXPECT().Error([]interface{}{`snapshot mismatch:
+   path: testdata/snapshots/TestLongDiff_1.snap
+   diff: (-snapshot +got)
+  ...
+  line 9
+- line 10
++ LINE 10
+  line 11
+  ...
+…and 1 more difference
+set UPDATE_SNAPSHOTS=1 to update the snapshot`})
+		assert.MatchSnapshot(g, strings.Join(lines, "\n"))
+	})
+}
@@ -0,0 +1,174 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package sqlassert offers assertions on the results of database/sql
+// queries and on database mocks, such as those of go-sqlmock.
+package sqlassert
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/lvan100/go-assert"
+)
+
+// RowsAssertion encapsulates the result of a query and a test handler for
+// making assertions on it. Assertions advancing the rows consume them, so
+// they apply in order:
+//
+//	rows, err := db.Query("SELECT id, name FROM users ORDER BY id")
+//	assert.ThatError(t, err).IsNil()
+//	var id int
+//	var name string
+//	sqlassert.ThatRows(t, rows).
+//		ColumnNames("id", "name").
+//		NextRowScansTo(&id, &name).
+//		RowCount(2)
+type RowsAssertion struct {
+	t    assert.T
+	rows *sql.Rows
+}
+
+// ThatRows returns a RowsAssertion for the given testing object and rows.
+func ThatRows(t assert.T, rows *sql.Rows) *RowsAssertion {
+	return &RowsAssertion{
+		t:    assert.Chain(t),
+		rows: rows,
+	}
+}
+
+// ColumnNames reports a test failure if the column names of the rows are
+// not exactly the expected names, in order.
+func (a *RowsAssertion) ColumnNames(expect ...string) *RowsAssertion {
+	a.t.Helper()
+	assert.Check(a.t, func() assert.Result {
+		got, err := a.rows.Columns()
+		if err != nil {
+			return assert.ResultFailure("failed to get columns: %v", err)
+		}
+		if !slices.Equal(got, expect) {
+			return assert.ResultFailure(`columns mismatch:
+    got: %q
+ expect: %q`, got, expect)
+		}
+		return assert.ResultSuccess()
+	})
+	return a
+}
+
+// NextRowScansTo advances to the next row and scans its columns into dest,
+// as by sql.Rows.Scan. It reports a test failure if there is no next row or
+// the row cannot be scanned.
+func (a *RowsAssertion) NextRowScansTo(dest ...interface{}) *RowsAssertion {
+	a.t.Helper()
+	assert.Check(a.t, func() assert.Result {
+		if !a.rows.Next() {
+			str := "expect a next row but got none"
+			if err := a.rows.Err(); err != nil {
+				str += fmt.Sprintf("\n  error: %v", err)
+			}
+			return assert.ResultFailure("%s", str)
+		}
+		if err := a.rows.Scan(dest...); err != nil {
+			return assert.ResultFailure("failed to scan row: %v", err)
+		}
+		return assert.ResultSuccess()
+	})
+	return a
+}
+
+// RowCount consumes and closes the remaining rows, reporting a test failure
+// if their number is not n or iterating them fails.
+func (a *RowsAssertion) RowCount(n int, msg ...interface{}) *RowsAssertion {
+	a.t.Helper()
+	assert.Check(a.t, func() assert.Result {
+		defer a.rows.Close()
+		count := 0
+		for a.rows.Next() {
+			count++
+		}
+		if err := a.rows.Err(); err != nil {
+			return assert.ResultFailure("failed to iterate rows: %v", err)
+		}
+		if count != n {
+			return assert.ResultFailure("got %d row(s) but expect %d", count, n)
+		}
+		return assert.ResultSuccess()
+	}, msg...)
+	return a
+}
+
+// RowAssertion encapsulates the result of a query returning at most one row
+// and a test handler for making assertions on it.
+type RowAssertion struct {
+	t   assert.T
+	row *sql.Row
+}
+
+// ThatRow returns a RowAssertion for the given testing object and row.
+func ThatRow(t assert.T, row *sql.Row) *RowAssertion {
+	return &RowAssertion{
+		t:   t,
+		row: row,
+	}
+}
+
+// ScansTo scans the columns of the row into dest, as by sql.Row.Scan. It
+// reports a test failure if there is no row or the row cannot be scanned.
+func (a *RowAssertion) ScansTo(dest ...interface{}) bool {
+	a.t.Helper()
+	return assert.Check(a.t, func() assert.Result {
+		if err := a.row.Scan(dest...); err != nil {
+			return assert.ResultFailure("failed to scan row: %v", err)
+		}
+		return assert.ResultSuccess()
+	})
+}
+
+// IsNoRows reports a test failure unless the query returned no row.
+func (a *RowAssertion) IsNoRows(msg ...interface{}) bool {
+	a.t.Helper()
+	return assert.Check(a.t, func() assert.Result {
+		if err := a.row.Err(); err != nil {
+			return assert.ResultFailure("expect no rows, got error: %v", err)
+		}
+		// without destinations, Scan fails with a different error if a row exists
+		if err := a.row.Scan(); !errors.Is(err, sql.ErrNoRows) {
+			return assert.ResultFailure("expect no rows, got a row")
+		}
+		return assert.ResultSuccess()
+	}, msg...)
+}
+
+// ExpectationsMet reports a test failure if the expectations of a database
+// mock, such as a sqlmock.Sqlmock, were not all met:
+//
+//	db, mock, _ := sqlmock.New()
+//	mock.ExpectQuery("SELECT").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+//	repo := NewRepo(db)
+//	...
+//	sqlassert.ExpectationsMet(t, mock)
+func ExpectationsMet(t assert.T, m interface{ ExpectationsWereMet() error }, msg ...interface{}) bool {
+	t.Helper()
+	return assert.Check(t, func() assert.Result {
+		if err := m.ExpectationsWereMet(); err != nil {
+			return assert.ResultFailure("unmet expectations: %v", err)
+		}
+		return assert.ResultSuccess()
+	}, msg...)
+}
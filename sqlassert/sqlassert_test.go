@@ -0,0 +1,131 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sqlassert_test
+
+import (
+	"database/sql"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/internal/mock"
+	"github.com/lvan100/go-assert/sqlassert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestMain(m *testing.M) {
+	assert.Configure(func(s *assert.Settings) {
+		s.Color = assert.ColorNever
+	})
+	os.Exit(m.Run())
+}
+
+func runCase(t *testing.T, f func(g *mock.MockT)) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	g := mock.NewMockT(ctrl)
+	g.EXPECT().Helper().AnyTimes()
+	f(g)
+}
+
+func newMockDB(t *testing.T) (*sql.DB, sqlmock.Sqlmock) {
+	db, dbMock, err := sqlmock.New()
+	assert.ThatError(t, err).IsNil()
+	t.Cleanup(func() { db.Close() })
+	return db, dbMock
+}
+
+func TestRows(t *testing.T) {
+	db, dbMock := newMockDB(t)
+	dbMock.ExpectQuery("SELECT").WillReturnRows(
+		sqlmock.NewRows([]string{"id", "name"}).AddRow(1, "alice").AddRow(2, "bob").AddRow(3, "carol"))
+	rows, err := db.Query("SELECT id, name FROM users")
+	assert.ThatError(t, err).IsNil()
+
+	runCase(t, func(g *mock.MockT) {
+		var id int
+		var name string
+		sqlassert.ThatRows(g, rows).
+			ColumnNames("id", "name").
+			NextRowScansTo(&id, &name).
+			RowCount(2)
+		assert.ThatNumber(t, id).Equal(1)
+		assert.ThatString(t, name).Equal("alice")
+	})
+
+	dbMock.ExpectQuery("SELECT").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	rows, err = db.Query("SELECT id FROM users")
+	assert.ThatError(t, err).IsNil()
+
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"columns mismatch:\n    got: [\"id\"]\n expect: [\"name\"]"})
+		g.EXPECT().Error([]interface{}{"expect a next row but got none"})
+		g.EXPECT().Error([]interface{}{"got 0 row(s) but expect 1\nmessage: users"})
+		var id int
+		sqlassert.ThatRows(assert.New(g).WithContinueChains(true), rows).
+			ColumnNames("name").
+			NextRowScansTo(&id).
+			NextRowScansTo(&id).
+			RowCount(1, "users")
+	})
+
+	sqlassert.ExpectationsMet(t, dbMock)
+}
+
+func TestRow(t *testing.T) {
+	db, dbMock := newMockDB(t)
+
+	dbMock.ExpectQuery("SELECT").WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("alice"))
+	runCase(t, func(g *mock.MockT) {
+		var name string
+		sqlassert.ThatRow(g, db.QueryRow("SELECT name FROM users WHERE id = 1")).ScansTo(&name)
+		assert.ThatString(t, name).Equal("alice")
+	})
+
+	dbMock.ExpectQuery("SELECT").WillReturnRows(sqlmock.NewRows([]string{"name"}))
+	runCase(t, func(g *mock.MockT) {
+		sqlassert.ThatRow(g, db.QueryRow("SELECT name FROM users WHERE id = 2")).IsNoRows()
+	})
+
+	dbMock.ExpectQuery("SELECT").WillReturnRows(sqlmock.NewRows([]string{"name"}))
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"failed to scan row: sql: no rows in result set"})
+		var name string
+		sqlassert.ThatRow(g, db.QueryRow("SELECT name FROM users WHERE id = 3")).ScansTo(&name)
+	})
+
+	dbMock.ExpectQuery("SELECT").WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("bob"))
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"expect no rows, got a row"})
+		sqlassert.ThatRow(g, db.QueryRow("SELECT name FROM users WHERE id = 4")).IsNoRows()
+	})
+
+	sqlassert.ExpectationsMet(t, dbMock)
+}
+
+func TestExpectationsMet(t *testing.T) {
+	_, dbMock := newMockDB(t)
+	dbMock.ExpectExec("DELETE")
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error(gomock.Cond(func(args []interface{}) bool {
+			return strings.HasPrefix(args[0].(string), "unmet expectations: there is a remaining expectation")
+		}))
+		sqlassert.ExpectationsMet(g, dbMock)
+	})
+}
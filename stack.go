@@ -0,0 +1,96 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/lvan100/go-assert/internal"
+)
+
+// maxStackDepth is the maximum number of frames in a stack trace.
+const maxStackDepth = 64
+
+// stackTraceOf reports whether failures reported through t include a stack
+// trace: as set by the outermost Asserter setting it, or else by the
+// package-level setting.
+func stackTraceOf(t internal.T) bool {
+	for _, a := range asserterOf(t) {
+		if a.stackTrace != nil {
+			return *a.stackTrace
+		}
+	}
+	return currentSettings().StackTrace
+}
+
+// writeStackTrace writes the stack of the caller to b as indented
+// "function" and "file:line" lines. Frames of this package and of its
+// subpackages are left out, and the trace stops at the testing framework or
+// at the start of the goroutine.
+func writeStackTrace(b *bytes.Buffer) {
+	pc := make([]uintptr, maxStackDepth)
+	n := runtime.Callers(2, pc)
+	frames := runtime.CallersFrames(pc[:n])
+	for {
+		frame, more := frames.Next()
+		if stackEnd(frame.Function) {
+			break
+		}
+		if !inPackage(frame.Function) {
+			fmt.Fprintf(b, "\n    %s\n        %s:%d", frame.Function, frame.File, frame.Line)
+		}
+		if !more {
+			break
+		}
+	}
+}
+
+// callerLocation returns the "file.go:123" location of the first caller
+// outside this package and its subpackages, or "" if there is none.
+func callerLocation() string {
+	pc := make([]uintptr, maxStackDepth)
+	n := runtime.Callers(2, pc)
+	frames := runtime.CallersFrames(pc[:n])
+	for {
+		frame, more := frames.Next()
+		if stackEnd(frame.Function) {
+			return ""
+		}
+		if !inPackage(frame.Function) {
+			return fmt.Sprintf("%s:%d", filepath.Base(frame.File), frame.Line)
+		}
+		if !more {
+			return ""
+		}
+	}
+}
+
+// inPackage reports whether the function belongs to this package or one of
+// its subpackages.
+func inPackage(function string) bool {
+	return strings.HasPrefix(function, pkgPath) ||
+		strings.HasPrefix(function, strings.TrimSuffix(pkgPath, ".")+"/")
+}
+
+// stackEnd reports whether the function is where a trace stops.
+func stackEnd(function string) bool {
+	return strings.HasPrefix(function, "testing.") || function == "runtime.goexit"
+}
@@ -0,0 +1,93 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/internal"
+	"github.com/lvan100/go-assert/internal/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func checkPositive(t internal.T, n int) {
+	assert.ThatNumber(t, n).GreaterThan(0)
+}
+
+func TestStackTrace(t *testing.T) {
+	stack := regexp.MustCompile(`^got \(int\) -1 but expect greater than \(int\) 0
+  stack:
+    github.com/lvan100/go-assert_test.checkPositive
+        .*/stack_test.go:\d+
+    github.com/lvan100/go-assert_test.TestStackTrace.func\d+\.\d+
+        .*/stack_test.go:\d+
+`)
+	t.Run("asserter", func(t *testing.T) {
+		runCase(t, func(g *mock.MockT) {
+			g.EXPECT().Error(gomock.Any()).Do(func(args ...interface{}) {
+				assert.ThatString(t, args[0].(string)).Matches(stack.String())
+			})
+			checkPositive(assert.New(g).WithStackTrace(true), -1)
+		})
+	})
+	t.Run("global", func(t *testing.T) {
+		defer assert.Configure(func(s *assert.Settings) {
+			s.StackTrace = true
+		})()
+		runCase(t, func(g *mock.MockT) {
+			g.EXPECT().Error(gomock.Any()).Do(func(args ...interface{}) {
+				assert.ThatString(t, args[0].(string)).Matches(stack.String())
+			})
+			checkPositive(g, -1)
+		})
+		runCase(t, func(g *mock.MockT) {
+			g.EXPECT().Error([]interface{}{"got (int) -1 but expect greater than (int) 0"})
+			checkPositive(assert.New(g).WithStackTrace(false), -1)
+		})
+	})
+}
+
+func TestCallerLocation(t *testing.T) {
+	matches := func(expr string) gomock.Matcher {
+		return gomock.Cond(func(args []interface{}) bool {
+			return regexp.MustCompile(expr).MatchString(args[0].(string))
+		})
+	}
+
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"got false but expect true"})
+		assert.True(g, false)
+	})
+
+	defer assert.Configure(func(s *assert.Settings) {
+		s.CallerLocation = true
+	})()
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error(matches(`^stack_test\.go:\d+: creating user: got false but expect true$`))
+		assert.True(assert.New(g).WithContext("creating user"), false)
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error(matches(`^stack_test\.go:\d+: got \(int\) 0 but expect greater than \(int\) 0$`))
+		checkPositive(g, 0)
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"got false but expect true"})
+		assert.True(assert.New(g).WithCallerLocation(false), false)
+	})
+}
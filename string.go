@@ -17,61 +17,90 @@
 package assert
 
 import (
-	"encoding/json"
 	"fmt"
-	"reflect"
 	"regexp"
 	"strings"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/lvan100/go-assert/internal"
 )
 
 // StringAssertion encapsulates a string value and a test handler for making assertions on the string.
 type StringAssertion struct {
-	t internal.T
-	v string
+	t         internal.T
+	v         string
+	normalize bool // see IgnoringLineEndings
 }
 
 // ThatString returns a StringAssertion for the given testing object and string value.
 func ThatString(t internal.T, v string) *StringAssertion {
 	return &StringAssertion{
-		t: t,
+		t: chain(t),
 		v: v,
 	}
 }
 
+// IgnoringLineEndings returns a StringAssertion that normalizes CRLF and CR
+// line endings to LF and strips a leading UTF-8 byte order mark, on both the
+// actual string and the strings it is compared with.
+func (a *StringAssertion) IgnoringLineEndings() *StringAssertion {
+	return &StringAssertion{
+		t:         a.t,
+		v:         normalizeText(a.v),
+		normalize: true,
+	}
+}
+
+// norm normalizes s if the assertion ignores line endings.
+func (a *StringAssertion) norm(s string) string {
+	if a.normalize {
+		return normalizeText(s)
+	}
+	return s
+}
+
 // Length reports a test failure if the actual string's length is not equal to the expected length.
-func (a *StringAssertion) Length(length int, msg ...string) *StringAssertion {
+func (a *StringAssertion) Length(length int, msg ...interface{}) *StringAssertion {
 	a.t.Helper()
+	defer track(a.t, a.v)()
 	if len(a.v) != length {
 		str := fmt.Sprintf(`length mismatch:
     got: length %d (%T) %q
- expect: length %d`, len(a.v), a.v, a.v, length)
+ expect: length %d`, len(a.v), a.v, show(a.t, a.v), length)
 		fail(a.t, str, msg...)
 	}
 	return a
 }
 
 // Equal reports a test failure if the actual string is not equal to the expected string.
-func (a *StringAssertion) Equal(expect string, msg ...string) *StringAssertion {
+func (a *StringAssertion) Equal(expect string, msg ...interface{}) *StringAssertion {
 	a.t.Helper()
+	defer track(a.t, a.v)()
+	expect = a.norm(expect)
 	if a.v != expect {
 		str := fmt.Sprintf(`strings not equal:
     got: (%T) %q
- expect: (%T) %q`, a.v, a.v, expect, expect)
-		fail(a.t, str, msg...)
+ expect: (%T) %q`, a.v, show(a.t, a.v), expect, show(a.t, expect))
+		if isMultiline(a.v, expect) && diffable(a.v, expect) && normalizeText(a.v) != normalizeText(expect) {
+			// multi-line strings are easier to compare line by line, unless
+			// they only differ in line endings, which lineEndingNote reports
+			str = "strings not equal:\n" + unifiedDiff(expect, a.v)
+		}
+		failValues(a.t, a.v, expect, str+lineEndingNote(a.v, expect), msg...)
 	}
 	return a
 }
 
 // NotEqual reports a test failure if the actual string is equal to the given string.
-func (a *StringAssertion) NotEqual(expect string, msg ...string) *StringAssertion {
+func (a *StringAssertion) NotEqual(expect string, msg ...interface{}) *StringAssertion {
 	a.t.Helper()
+	defer track(a.t, a.v)()
+	expect = a.norm(expect)
 	if a.v == expect {
 		str := fmt.Sprintf(`strings are equal:
     got: (%T) %q
- expect: not equal to %q`, a.v, a.v, expect)
-		fail(a.t, str, msg...)
+ expect: not equal to %q`, a.v, show(a.t, a.v), show(a.t, expect))
+		failValues(a.t, a.v, expect, str, msg...)
 	}
 	return a
 }
@@ -79,176 +108,210 @@ func (a *StringAssertion) NotEqual(expect string, msg ...string) *StringAssertio
 // JSONEqual unmarshals both the actual and expected JSON strings into generic interfaces,
 // then reports a test failure if their resulting structures are not deeply equal.
 // If either string is invalid JSON, the test will fail with the unmarshal error.
-func (a *StringAssertion) JSONEqual(expect string, msg ...string) {
+func (a *StringAssertion) JSONEqual(expect string, msg ...interface{}) (ok bool) {
+	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
+	return a.jsonEqual(expect, cmpOptionsOf(a.t), msg...)
+}
+
+// jsonEqual implements JSONEqual and JSONEqualCmp, comparing the decoded
+// documents with the go-cmp options opts if any.
+func (a *StringAssertion) jsonEqual(expect string, opts []cmp.Option, msg ...interface{}) bool {
 	a.t.Helper()
-	var gotJson interface{}
-	if err := json.Unmarshal([]byte(a.v), &gotJson); err != nil {
+	gotJson, err := canonicalJSON([]byte(a.v))
+	if err != nil {
 		str := fmt.Sprintf(`invalid JSON in got value:
     got: (%T) %q
  expect: (%T) %q
-  error: %v`, a.v, a.v, expect, expect, err)
-		fail(a.t, str, msg...)
-		return
+  error: %v`, a.v, show(a.t, a.v), expect, show(a.t, expect), err)
+		failValues(a.t, a.v, expect, str, msg...)
+		return false
 	}
-	var expectJson interface{}
-	if err := json.Unmarshal([]byte(expect), &expectJson); err != nil {
+	expectJson, err := canonicalJSON([]byte(expect))
+	if err != nil {
 		str := fmt.Sprintf(`invalid JSON in expect value:
     got: (%T) %q
  expect: (%T) %q
-  error: %v`, a.v, a.v, expect, expect, err)
-		fail(a.t, str, msg...)
-		return
+  error: %v`, a.v, show(a.t, a.v), expect, show(a.t, expect), err)
+		failValues(a.t, a.v, expect, str, msg...)
+		return false
 	}
-	if !reflect.DeepEqual(gotJson, expectJson) {
+	equal, ok := jsonEqual(a.t, gotJson, expectJson, opts, msg...)
+	if !ok {
+		return false
+	}
+	if !equal {
 		str := fmt.Sprintf(`JSON structures are not equal:
     got: (%T) %q
- expect: (%T) %q`, a.v, a.v, expect, expect)
-		fail(a.t, str, msg...)
+ expect: (%T) %q`, a.v, show(a.t, a.v), expect, show(a.t, expect))
+		failValues(a.t, a.v, expect, str, msg...)
+		return false
 	}
+	return true
 }
 
 // Matches reports a test failure if the actual string does not match the given regular expression.
-func (a *StringAssertion) Matches(expr string, msg ...string) {
+func (a *StringAssertion) Matches(expr string, msg ...interface{}) (ok bool) {
 	a.t.Helper()
-	if ok, err := regexp.MatchString(expr, a.v); !ok {
+	defer trackResult(a.t, a.v, &ok)()
+	if ok, err := matchString(expr, a.v); !ok {
 		str := fmt.Sprintf(`string does not match the pattern:
     got: (%T) %q
- expect: to match regex %q`, a.v, a.v, expr)
+ expect: to match regex %q`, a.v, show(a.t, a.v), expr)
 		if err != nil {
 			str += fmt.Sprintf("\n  error: %v", err)
 		}
 		fail(a.t, str, msg...)
+		return false
 	}
+	return true
 }
 
 // EqualFold reports a test failure if the actual string and the given string
 // are not equal under Unicode case-folding.
-func (a *StringAssertion) EqualFold(s string, msg ...string) {
+func (a *StringAssertion) EqualFold(s string, msg ...interface{}) (ok bool) {
 	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
+	s = a.norm(s)
 	if !strings.EqualFold(a.v, s) {
 		str := fmt.Sprintf(`strings are not equal under case-folding:
     got: (%T) %q
- expect: (%T) %q`, a.v, a.v, s, s)
+ expect: (%T) %q`, a.v, show(a.t, a.v), s, s)
 		fail(a.t, str, msg...)
+		return false
 	}
+	return true
 }
 
 // HasPrefix fails the test if the actual string does not start with the specified prefix.
-func (a *StringAssertion) HasPrefix(prefix string, msg ...string) *StringAssertion {
+func (a *StringAssertion) HasPrefix(prefix string, msg ...interface{}) *StringAssertion {
 	a.t.Helper()
+	defer track(a.t, a.v)()
+	prefix = a.norm(prefix)
 	if !strings.HasPrefix(a.v, prefix) {
 		str := fmt.Sprintf(`string does not start with the specified prefix:
     got: (%T) %q
- expect: to have prefix %q`, a.v, a.v, prefix)
+ expect: to have prefix %q`, a.v, show(a.t, a.v), show(a.t, prefix))
 		fail(a.t, str, msg...)
 	}
 	return a
 }
 
 // HasSuffix fails the test if the actual string does not end with the specified suffix.
-func (a *StringAssertion) HasSuffix(suffix string, msg ...string) *StringAssertion {
+func (a *StringAssertion) HasSuffix(suffix string, msg ...interface{}) *StringAssertion {
 	a.t.Helper()
+	defer track(a.t, a.v)()
+	suffix = a.norm(suffix)
 	if !strings.HasSuffix(a.v, suffix) {
 		str := fmt.Sprintf(`string does not end with the specified suffix:
     got: (%T) %q
- expect: to have suffix %q`, a.v, a.v, suffix)
+ expect: to have suffix %q`, a.v, show(a.t, a.v), show(a.t, suffix))
 		fail(a.t, str, msg...)
 	}
 	return a
 }
 
 // Contains fails the test if the actual string does not contain the specified substring.
-func (a *StringAssertion) Contains(substr string, msg ...string) *StringAssertion {
+func (a *StringAssertion) Contains(substr string, msg ...interface{}) *StringAssertion {
 	a.t.Helper()
+	defer track(a.t, a.v)()
+	substr = a.norm(substr)
 	if !strings.Contains(a.v, substr) {
 		str := fmt.Sprintf(`string does not contain the specified substring:
     got: (%T) %q
- expect: to contain substring %q`, a.v, a.v, substr)
+ expect: to contain substring %q`, a.v, show(a.t, a.v), show(a.t, substr))
 		fail(a.t, str, msg...)
 	}
 	return a
 }
 
 // IsEmpty reports a test failure if the actual string is not empty.
-func (a *StringAssertion) IsEmpty(msg ...string) *StringAssertion {
+func (a *StringAssertion) IsEmpty(msg ...interface{}) *StringAssertion {
 	a.t.Helper()
+	defer track(a.t, a.v)()
 	if a.v != "" {
 		str := fmt.Sprintf(`string is not empty:
     got: (%T) %q
- expect: empty string`, a.v, a.v)
+ expect: empty string`, a.v, show(a.t, a.v))
 		fail(a.t, str, msg...)
 	}
 	return a
 }
 
 // IsNotEmpty reports a test failure if the actual string is empty.
-func (a *StringAssertion) IsNotEmpty(msg ...string) *StringAssertion {
+func (a *StringAssertion) IsNotEmpty(msg ...interface{}) *StringAssertion {
 	a.t.Helper()
+	defer track(a.t, a.v)()
 	if a.v == "" {
 		str := fmt.Sprintf(`string is empty:
     got: (%T) %q
- expect: non-empty string`, a.v, a.v)
+ expect: non-empty string`, a.v, show(a.t, a.v))
 		fail(a.t, str, msg...)
 	}
 	return a
 }
 
 // IsBlank reports a test failure if the actual string is not blank (i.e., contains non-whitespace characters).
-func (a *StringAssertion) IsBlank(msg ...string) *StringAssertion {
+func (a *StringAssertion) IsBlank(msg ...interface{}) *StringAssertion {
 	a.t.Helper()
+	defer track(a.t, a.v)()
 	if strings.TrimSpace(a.v) != "" {
 		str := fmt.Sprintf(`string contains non-whitespace characters:
     got: (%T) %q
- expect: blank string`, a.v, a.v)
+ expect: blank string`, a.v, show(a.t, a.v))
 		fail(a.t, str, msg...)
 	}
 	return a
 }
 
 // IsNotBlank reports a test failure if the actual string is blank (i.e., empty or contains only whitespace characters).
-func (a *StringAssertion) IsNotBlank(msg ...string) *StringAssertion {
+func (a *StringAssertion) IsNotBlank(msg ...interface{}) *StringAssertion {
 	a.t.Helper()
+	defer track(a.t, a.v)()
 	if strings.TrimSpace(a.v) == "" {
 		str := fmt.Sprintf(`string is blank:
     got: (%T) %q
- expect: non-blank string`, a.v, a.v)
+ expect: non-blank string`, a.v, show(a.t, a.v))
 		fail(a.t, str, msg...)
 	}
 	return a
 }
 
 // IsLowerCase reports a test failure if the actual string contains any uppercase characters.
-func (a *StringAssertion) IsLowerCase(msg ...string) *StringAssertion {
+func (a *StringAssertion) IsLowerCase(msg ...interface{}) *StringAssertion {
 	a.t.Helper()
+	defer track(a.t, a.v)()
 	if a.v != strings.ToLower(a.v) {
 		str := fmt.Sprintf(`string contains uppercase characters:
     got: (%T) %q
- expect: lowercase string`, a.v, a.v)
+ expect: lowercase string`, a.v, show(a.t, a.v))
 		fail(a.t, str, msg...)
 	}
 	return a
 }
 
 // IsUpperCase reports a test failure if the actual string contains any lowercase characters.
-func (a *StringAssertion) IsUpperCase(msg ...string) *StringAssertion {
+func (a *StringAssertion) IsUpperCase(msg ...interface{}) *StringAssertion {
 	a.t.Helper()
+	defer track(a.t, a.v)()
 	if a.v != strings.ToUpper(a.v) {
 		str := fmt.Sprintf(`string contains lowercase characters:
     got: (%T) %q
- expect: uppercase string`, a.v, a.v)
+ expect: uppercase string`, a.v, show(a.t, a.v))
 		fail(a.t, str, msg...)
 	}
 	return a
 }
 
 // IsNumeric reports a test failure if the actual string contains any non-numeric characters.
-func (a *StringAssertion) IsNumeric(msg ...string) *StringAssertion {
+func (a *StringAssertion) IsNumeric(msg ...interface{}) *StringAssertion {
 	a.t.Helper()
+	defer track(a.t, a.v)()
 	for _, r := range a.v {
 		if r < '0' || r > '9' {
 			str := fmt.Sprintf(`string contains non-numeric characters:
     got: (%T) %q
- expect: numeric string`, a.v, a.v)
+ expect: numeric string`, a.v, show(a.t, a.v))
 			fail(a.t, str, msg...)
 			break
 		}
@@ -257,13 +320,14 @@ func (a *StringAssertion) IsNumeric(msg ...string) *StringAssertion {
 }
 
 // IsAlpha reports a test failure if the actual string contains any non-alphabetic characters.
-func (a *StringAssertion) IsAlpha(msg ...string) *StringAssertion {
+func (a *StringAssertion) IsAlpha(msg ...interface{}) *StringAssertion {
 	a.t.Helper()
+	defer track(a.t, a.v)()
 	for _, r := range a.v {
 		if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') {
 			str := fmt.Sprintf(`string contains non-alphabetic characters:
     got: (%T) %q
- expect: alphabetic string`, a.v, a.v)
+ expect: alphabetic string`, a.v, show(a.t, a.v))
 			fail(a.t, str, msg...)
 			break
 		}
@@ -272,13 +336,14 @@ func (a *StringAssertion) IsAlpha(msg ...string) *StringAssertion {
 }
 
 // IsAlphaNumeric reports a test failure if the actual string contains any non-alphanumeric characters.
-func (a *StringAssertion) IsAlphaNumeric(msg ...string) *StringAssertion {
+func (a *StringAssertion) IsAlphaNumeric(msg ...interface{}) *StringAssertion {
 	a.t.Helper()
+	defer track(a.t, a.v)()
 	for _, r := range a.v {
 		if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') && (r < '0' || r > '9') {
 			str := fmt.Sprintf(`string contains non-alphanumeric characters:
     got: (%T) %q
- expect: alphanumeric string`, a.v, a.v)
+ expect: alphanumeric string`, a.v, show(a.t, a.v))
 			fail(a.t, str, msg...)
 			break
 		}
@@ -286,67 +351,93 @@ func (a *StringAssertion) IsAlphaNumeric(msg ...string) *StringAssertion {
 	return a
 }
 
+// Patterns of the string format validators.
+var (
+	emailRegexp  = regexp.MustCompile(`^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`)
+	urlRegexp    = regexp.MustCompile(`^(https?|ftp):\/\/[^\s/$.?#].[^\s]*$`)
+	ipRegexp     = regexp.MustCompile(`^((25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\.){3}(25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)$`)
+	hexRegexp    = regexp.MustCompile(`^[0-9a-fA-F]+$`)
+	base64Regexp = regexp.MustCompile(`^(?:[A-Za-z0-9+/]{4})*(?:[A-Za-z0-9+/]{2}==|[A-Za-z0-9+/]{3}=)?$`)
+)
+
 // IsEmail reports a test failure if the actual string is not a valid email address.
-func (a *StringAssertion) IsEmail(msg ...string) *StringAssertion {
+func (a *StringAssertion) IsEmail(msg ...interface{}) *StringAssertion {
 	a.t.Helper()
-	emailRegex := `^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`
-	if ok, err := regexp.MatchString(emailRegex, a.v); err != nil || !ok {
+	defer track(a.t, a.v)()
+	if !emailRegexp.MatchString(a.v) {
 		str := fmt.Sprintf(`string is not a valid email:
     got: (%T) %q
- expect: valid email address`, a.v, a.v)
+ expect: valid email address`, a.v, show(a.t, a.v))
 		fail(a.t, str, msg...)
 	}
 	return a
 }
 
 // IsURL reports a test failure if the actual string is not a valid URL.
-func (a *StringAssertion) IsURL(msg ...string) *StringAssertion {
+func (a *StringAssertion) IsURL(msg ...interface{}) *StringAssertion {
 	a.t.Helper()
-	urlRegex := `^(https?|ftp):\/\/[^\s/$.?#].[^\s]*$`
-	if ok, err := regexp.MatchString(urlRegex, a.v); err != nil || !ok {
+	defer track(a.t, a.v)()
+	if !urlRegexp.MatchString(a.v) {
 		str := fmt.Sprintf(`string is not a valid URL:
     got: (%T) %q
- expect: valid URL`, a.v, a.v)
+ expect: valid URL`, a.v, show(a.t, a.v))
 		fail(a.t, str, msg...)
 	}
 	return a
 }
 
 // IsIP reports a test failure if the actual string is not a valid IP address.
-func (a *StringAssertion) IsIP(msg ...string) *StringAssertion {
+func (a *StringAssertion) IsIP(msg ...interface{}) *StringAssertion {
 	a.t.Helper()
-	ipRegex := `^((25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\.){3}(25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)$`
-	if ok, err := regexp.MatchString(ipRegex, a.v); err != nil || !ok {
+	defer track(a.t, a.v)()
+	if !ipRegexp.MatchString(a.v) {
 		str := fmt.Sprintf(`string is not a valid IP:
     got: (%T) %q
- expect: valid IP address`, a.v, a.v)
+ expect: valid IP address`, a.v, show(a.t, a.v))
 		fail(a.t, str, msg...)
 	}
 	return a
 }
 
 // IsHex reports a test failure if the actual string is not a valid hexadecimal number.
-func (a *StringAssertion) IsHex(msg ...string) *StringAssertion {
+func (a *StringAssertion) IsHex(msg ...interface{}) *StringAssertion {
 	a.t.Helper()
-	hexRegex := `^[0-9a-fA-F]+$`
-	if ok, err := regexp.MatchString(hexRegex, a.v); err != nil || !ok {
+	defer track(a.t, a.v)()
+	if !hexRegexp.MatchString(a.v) {
 		str := fmt.Sprintf(`string is not a valid hexadecimal:
     got: (%T) %q
- expect: valid hexadecimal number`, a.v, a.v)
+ expect: valid hexadecimal number`, a.v, show(a.t, a.v))
 		fail(a.t, str, msg...)
 	}
 	return a
 }
 
 // IsBase64 reports a test failure if the actual string is not a valid Base64 encoded string.
-func (a *StringAssertion) IsBase64(msg ...string) *StringAssertion {
+func (a *StringAssertion) IsBase64(msg ...interface{}) *StringAssertion {
 	a.t.Helper()
-	base64Regex := `^(?:[A-Za-z0-9+/]{4})*(?:[A-Za-z0-9+/]{2}==|[A-Za-z0-9+/]{3}=)?$`
-	if ok, err := regexp.MatchString(base64Regex, a.v); err != nil || !ok {
+	defer track(a.t, a.v)()
+	if !base64Regexp.MatchString(a.v) {
 		str := fmt.Sprintf(`string is not a valid Base64:
     got: (%T) %q
- expect: valid Base64 encoded string`, a.v, a.v)
+ expect: valid Base64 encoded string`, a.v, show(a.t, a.v))
 		fail(a.t, str, msg...)
 	}
 	return a
 }
+
+// normalizeText converts CRLF and CR line endings to LF and strips a leading
+// UTF-8 byte order mark.
+func normalizeText(s string) string {
+	s = strings.TrimPrefix(s, "\ufeff")
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	return strings.ReplaceAll(s, "\r", "\n")
+}
+
+// lineEndingNote returns a note for failure messages when got and expect
+// differ only in line endings or a byte order mark, or "" otherwise.
+func lineEndingNote(got, expect string) string {
+	if got != expect && normalizeText(got) == normalizeText(expect) {
+		return "\n   note: strings differ only in line endings or byte order mark"
+	}
+	return ""
+}
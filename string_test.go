@@ -17,23 +17,24 @@
 package assert_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/lvan100/go-assert"
-	"github.com/lvan100/go-assert/internal"
+	"github.com/lvan100/go-assert/internal/mock"
 )
 
 func TestString_Equal(t *testing.T) {
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		assert.ThatString(g, "0").Equal("0")
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{`strings not equal:
     got: (string) "0"
  expect: (string) "1"`})
 		assert.ThatString(g, "0").Equal("1")
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{`strings not equal:
     got: (string) "0"
  expect: (string) "1"
@@ -43,16 +44,16 @@ message: param (index=0)`})
 }
 
 func TestString_NotEqual(t *testing.T) {
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		assert.ThatString(g, "0").NotEqual("1")
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{`strings are equal:
     got: (string) "0"
  expect: not equal to "0"`})
 		assert.ThatString(g, "0").NotEqual("0")
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{`strings are equal:
     got: (string) "0"
  expect: not equal to "0"
@@ -62,72 +63,106 @@ message: param (index=0)`})
 }
 
 func TestString_JSONEqual(t *testing.T) {
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		assert.ThatString(g, `{"a":0,"b":1}`).JSONEqual(`{"b":1,"a":0}`)
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{`invalid JSON in got value:
     got: (string) "this is an error"
  expect: (string) "[{\"b\":1},{\"a\":0}]"
   error: invalid character 'h' in literal true (expecting 'r')`})
 		assert.ThatString(g, `this is an error`).JSONEqual(`[{"b":1},{"a":0}]`)
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{`invalid JSON in expect value:
     got: (string) "{\"a\":0,\"b\":1}"
  expect: (string) "this is an error"
   error: invalid character 'h' in literal true (expecting 'r')`})
 		assert.ThatString(g, `{"a":0,"b":1}`).JSONEqual(`this is an error`)
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{`JSON structures are not equal:
     got: (string) "{\"a\":0,\"b\":1}"
  expect: (string) "[{\"b\":1},{\"a\":0}]"`})
 		assert.ThatString(g, `{"a":0,"b":1}`).JSONEqual(`[{"b":1},{"a":0}]`)
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{`JSON structures are not equal:
     got: (string) "{\"a\":0}"
  expect: (string) "{\"a\":1}"
 message: param (index=0)`})
 		assert.ThatString(g, `{"a":0}`).JSONEqual(`{"a":1}`, "param (index=0)")
 	})
+	runCase(t, func(g *mock.MockT) {
+		assert.ThatString(g, `{"a":{"c":[1,{"e":null,"d":true}],"b":"x"}}`).JSONEqual(`{"a":{"b":"x","c":[1.0,{"d":true,"e":null}]}}`)
+		assert.ThatString(g, `[1e2,-0,"\u0041"]`).JSONEqual(`[100,0,"A"]`)
+		assert.ThatString(g, `{"a":1,"a":2}`).JSONEqual(`{"a":2}`)
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`JSON structures are not equal:
+    got: (string) "[1,2]"
+ expect: (string) "[2,1]"`})
+		assert.ThatString(g, `[1,2]`).JSONEqual(`[2,1]`)
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`invalid JSON in got value:
+    got: (string) "1e400"
+ expect: (string) "1"
+  error: json: cannot unmarshal number 1e400 into Go value of type float64`})
+		assert.ThatString(g, `1e400`).JSONEqual(`1`)
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`invalid JSON in expect value:
+    got: (string) "{}"
+ expect: (string) "{} x"
+  error: invalid character 'x' after top-level value`})
+		assert.ThatString(g, `{}`).JSONEqual(`{} x`)
+	})
 }
 
 func TestString_Matches(t *testing.T) {
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		assert.ThatString(g, "this is an error").Matches("this is an error")
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{"string does not match the pattern:\n    got: (string) \"this is an error\"\n expect: to match regex \"an error (\"\n  error: error parsing regexp: missing closing ): `an error (`"})
 		assert.ThatString(g, "this is an error").Matches("an error (")
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{`string does not match the pattern:
     got: (string) "there's no error"
  expect: to match regex "an error"`})
 		assert.ThatString(g, "there's no error").Matches("an error")
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{`string does not match the pattern:
     got: (string) "there's no error"
  expect: to match regex "an error"
 message: param (index=0)`})
 		assert.ThatString(g, "there's no error").Matches("an error", "param (index=0)")
 	})
+	runCase(t, func(g *mock.MockT) {
+		// more patterns than are cached, each matched twice
+		for i := 0; i < 300; i++ {
+			assert.ThatString(g, fmt.Sprintf("id-%d", i)).Matches(fmt.Sprintf("^id-%d$", i))
+		}
+		for i := 0; i < 300; i++ {
+			assert.ThatString(g, fmt.Sprintf("id-%d", i)).Matches(fmt.Sprintf("^id-%d$", i))
+		}
+	})
 }
 
 func TestString_EqualFold(t *testing.T) {
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		assert.ThatString(g, "hello, world!").EqualFold("Hello, World!")
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{`strings are not equal under case-folding:
     got: (string) "hello, world!"
  expect: (string) "xxx"`})
 		assert.ThatString(g, "hello, world!").EqualFold("xxx")
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{`strings are not equal under case-folding:
     got: (string) "hello, world!"
  expect: (string) "xxx"
@@ -137,16 +172,16 @@ message: param (index=0)`})
 }
 
 func TestString_HasPrefix(t *testing.T) {
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		assert.ThatString(g, "hello, world!").HasPrefix("hello")
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{`string does not start with the specified prefix:
     got: (string) "hello, world!"
  expect: to have prefix "xxx"`})
 		assert.ThatString(g, "hello, world!").HasPrefix("xxx")
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{`string does not start with the specified prefix:
     got: (string) "hello, world!"
  expect: to have prefix "xxx"
@@ -156,16 +191,16 @@ message: param (index=0)`})
 }
 
 func TestString_HasSuffix(t *testing.T) {
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		assert.ThatString(g, "hello, world!").HasSuffix("world!")
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{`string does not end with the specified suffix:
     got: (string) "hello, world!"
  expect: to have suffix "xxx"`})
 		assert.ThatString(g, "hello, world!").HasSuffix("xxx")
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{`string does not end with the specified suffix:
     got: (string) "hello, world!"
  expect: to have suffix "xxx"
@@ -175,16 +210,16 @@ message: param (index=0)`})
 }
 
 func TestString_Contains(t *testing.T) {
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		assert.ThatString(g, "hello, world!").Contains("hello")
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{`string does not contain the specified substring:
     got: (string) "hello, world!"
  expect: to contain substring "xxx"`})
 		assert.ThatString(g, "hello, world!").Contains("xxx")
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{`string does not contain the specified substring:
     got: (string) "hello, world!"
  expect: to contain substring "xxx"
@@ -194,16 +229,16 @@ message: param (index=0)`})
 }
 
 func TestString_IsEmpty(t *testing.T) {
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		assert.ThatString(g, "").IsEmpty()
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{`string is not empty:
     got: (string) "hello"
  expect: empty string`})
 		assert.ThatString(g, "hello").IsEmpty()
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{`string is not empty:
     got: (string) "hello"
  expect: empty string
@@ -213,16 +248,16 @@ message: param (index=0)`})
 }
 
 func TestString_IsNotEmpty(t *testing.T) {
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		assert.ThatString(g, "hello").IsNotEmpty()
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{`string is empty:
     got: (string) ""
  expect: non-empty string`})
 		assert.ThatString(g, "").IsNotEmpty()
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{`string is empty:
     got: (string) ""
  expect: non-empty string
@@ -232,16 +267,16 @@ message: param (index=0)`})
 }
 
 func TestString_IsBlank(t *testing.T) {
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		assert.ThatString(g, "   ").IsBlank()
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{`string contains non-whitespace characters:
     got: (string) "hello"
  expect: blank string`})
 		assert.ThatString(g, "hello").IsBlank()
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{`string contains non-whitespace characters:
     got: (string) "hello"
  expect: blank string
@@ -251,16 +286,16 @@ message: param (index=0)`})
 }
 
 func TestString_IsNotBlank(t *testing.T) {
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		assert.ThatString(g, "hello").IsNotBlank()
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{`string is blank:
     got: (string) "   "
  expect: non-blank string`})
 		assert.ThatString(g, "   ").IsNotBlank()
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{`string is blank:
     got: (string) "   "
  expect: non-blank string
@@ -270,16 +305,16 @@ message: param (index=0)`})
 }
 
 func TestString_IsLowerCase(t *testing.T) {
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		assert.ThatString(g, "hello").IsLowerCase()
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{`string contains uppercase characters:
     got: (string) "Hello"
  expect: lowercase string`})
 		assert.ThatString(g, "Hello").IsLowerCase()
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{`string contains uppercase characters:
     got: (string) "Hello"
  expect: lowercase string
@@ -289,16 +324,16 @@ message: param (index=0)`})
 }
 
 func TestString_IsUpperCase(t *testing.T) {
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		assert.ThatString(g, "HELLO").IsUpperCase()
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{`string contains lowercase characters:
     got: (string) "Hello"
  expect: uppercase string`})
 		assert.ThatString(g, "Hello").IsUpperCase()
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{`string contains lowercase characters:
     got: (string) "Hello"
  expect: uppercase string
@@ -308,16 +343,16 @@ message: param (index=0)`})
 }
 
 func TestString_IsNumeric(t *testing.T) {
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		assert.ThatString(g, "12345").IsNumeric()
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{`string contains non-numeric characters:
     got: (string) "123a45"
  expect: numeric string`})
 		assert.ThatString(g, "123a45").IsNumeric()
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{`string contains non-numeric characters:
     got: (string) "123a45"
  expect: numeric string
@@ -327,16 +362,16 @@ message: param (index=0)`})
 }
 
 func TestString_IsAlpha(t *testing.T) {
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		assert.ThatString(g, "abcdef").IsAlpha()
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{`string contains non-alphabetic characters:
     got: (string) "abc123"
  expect: alphabetic string`})
 		assert.ThatString(g, "abc123").IsAlpha()
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{`string contains non-alphabetic characters:
     got: (string) "abc123"
  expect: alphabetic string
@@ -346,16 +381,16 @@ message: param (index=0)`})
 }
 
 func TestString_IsAlphaNumeric(t *testing.T) {
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		assert.ThatString(g, "abc123").IsAlphaNumeric()
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{`string contains non-alphanumeric characters:
     got: (string) "abc@123"
  expect: alphanumeric string`})
 		assert.ThatString(g, "abc@123").IsAlphaNumeric()
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{`string contains non-alphanumeric characters:
     got: (string) "abc@123"
  expect: alphanumeric string
@@ -365,16 +400,16 @@ message: param (index=0)`})
 }
 
 func TestString_IsEmail(t *testing.T) {
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		assert.ThatString(g, "test@example.com").IsEmail()
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{`string is not a valid email:
     got: (string) "invalid-email"
  expect: valid email address`})
 		assert.ThatString(g, "invalid-email").IsEmail()
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{`string is not a valid email:
     got: (string) "invalid-email"
  expect: valid email address
@@ -384,16 +419,16 @@ message: param (index=0)`})
 }
 
 func TestString_IsURL(t *testing.T) {
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		assert.ThatString(g, "https://www.example.com").IsURL()
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{`string is not a valid URL:
     got: (string) "invalid-url"
  expect: valid URL`})
 		assert.ThatString(g, "invalid-url").IsURL()
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{`string is not a valid URL:
     got: (string) "invalid-url"
  expect: valid URL
@@ -403,16 +438,16 @@ message: param (index=0)`})
 }
 
 func TestString_IsIP(t *testing.T) {
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		assert.ThatString(g, "192.168.1.1").IsIP()
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{`string is not a valid IP:
     got: (string) "invalid-ip"
  expect: valid IP address`})
 		assert.ThatString(g, "invalid-ip").IsIP()
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{`string is not a valid IP:
     got: (string) "invalid-ip"
  expect: valid IP address
@@ -422,16 +457,16 @@ message: param (index=0)`})
 }
 
 func TestString_IsHex(t *testing.T) {
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		assert.ThatString(g, "abcdef123456").IsHex()
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{`string is not a valid hexadecimal:
     got: (string) "abcdefg"
  expect: valid hexadecimal number`})
 		assert.ThatString(g, "abcdefg").IsHex()
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{`string is not a valid hexadecimal:
     got: (string) "abcdefg"
  expect: valid hexadecimal number
@@ -441,16 +476,16 @@ message: param (index=0)`})
 }
 
 func TestString_IsBase64(t *testing.T) {
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		assert.ThatString(g, "SGVsbG8gd29ybGQ=").IsBase64()
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{`string is not a valid Base64:
     got: (string) "invalid-base64"
  expect: valid Base64 encoded string`})
 		assert.ThatString(g, "invalid-base64").IsBase64()
 	})
-	runCase(t, func(g *internal.MockT) {
+	runCase(t, func(g *mock.MockT) {
 		g.EXPECT().Error([]interface{}{`string is not a valid Base64:
     got: (string) "invalid-base64"
  expect: valid Base64 encoded string
@@ -458,3 +493,38 @@ message: param (index=0)`})
 		assert.ThatString(g, "invalid-base64").IsBase64("param (index=0)")
 	})
 }
+
+func TestString_IgnoringLineEndings(t *testing.T) {
+	runCase(t, func(g *mock.MockT) {
+		assert.ThatString(g, "\ufeffa\r\nb\r\n").IgnoringLineEndings().
+			Equal("a\nb\n").
+			HasPrefix("a\r\n").
+			Contains("a\rb").
+			HasSuffix("b\n")
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`strings not equal:
+    got: (string) "a\r\nb\r\n"
+ expect: (string) "a\nb\n"
+   note: strings differ only in line endings or byte order mark`})
+		assert.ThatString(g, "a\r\nb\r\n").Equal("a\nb\n")
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"strings not equal:\n   diff: (-expect +got)\n  a\n- c\n+ b\n  "})
+		assert.ThatString(g, "a\r\nb\r\n").IgnoringLineEndings().Equal("a\r\nc\r\n")
+	})
+}
+
+func TestString_EqualMultiline(t *testing.T) {
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`strings not equal:
+   diff: (-expect +got)
+  server:
+-   port: 8080
++   port: 9090
+    host: localhost
+message: config`})
+		got := "server:\n  port: 9090\n  host: localhost"
+		assert.ThatString(g, got).Equal("server:\n  port: 8080\n  host: localhost", "config")
+	})
+}
@@ -0,0 +1,310 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"unsafe"
+
+	"github.com/lvan100/go-assert/internal"
+)
+
+// StructAssertion encapsulates a struct value and a test handler for making
+// assertions on individual fields. Field paths are dotted, e.g. "Addr.City",
+// and pointers along the path are dereferenced. Unexported fields can be
+// inspected as well. Failures name the root struct type and the field path.
+type StructAssertion struct {
+	t      internal.T
+	v      reflect.Value // addressable struct value
+	root   reflect.Type
+	path   string // path of v from the root struct
+	broken bool   // navigation failed and has already been reported
+
+	include []string // relative field paths checked by NoZeroFields, nil means all
+	exclude []string // relative field paths skipped by NoZeroFields
+}
+
+// ThatStruct returns a StructAssertion for the given testing object and
+// value, which must be a struct or a non-nil pointer to a struct.
+func ThatStruct(t internal.T, v interface{}) *StructAssertion {
+	t.Helper()
+	a := &StructAssertion{t: chain(t), root: reflect.TypeOf(v)}
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		str := fmt.Sprintf(`expect struct or pointer to struct:
+    got: (%T) %v`, v, show(t, v))
+		fail(a.t, str)
+		a.broken = true
+		return a
+	}
+	a.v = addressable(rv)
+	return a
+}
+
+// addressable returns v, or an addressable copy of it if it is not, so that
+// its unexported fields can be read.
+func addressable(v reflect.Value) reflect.Value {
+	if v.CanAddr() {
+		return v
+	}
+	c := reflect.New(v.Type()).Elem()
+	c.Set(v)
+	return c
+}
+
+// join returns the full path of the relative field path.
+func (a *StructAssertion) join(path string) string {
+	if a.path == "" {
+		return path
+	}
+	return a.path + "." + path
+}
+
+// lookup resolves the dotted field path relative to a.v. On failure it
+// returns a description of the problem instead.
+func (a *StructAssertion) lookup(path string) (reflect.Value, string) {
+	v := a.v
+	var walked []string
+	for _, name := range strings.Split(path, ".") {
+		for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+			if v.IsNil() {
+				return reflect.Value{}, fmt.Sprintf("nil value at %q", a.join(strings.Join(walked, ".")))
+			}
+			v = v.Elem()
+		}
+		if v.Kind() != reflect.Struct {
+			return reflect.Value{}, fmt.Sprintf("(%s) at %q is not a struct", v.Type(), a.join(strings.Join(walked, ".")))
+		}
+		// structs held by interfaces are not addressable
+		v = addressable(v)
+		f := v.FieldByName(name)
+		walked = append(walked, name)
+		if !f.IsValid() {
+			return reflect.Value{}, fmt.Sprintf("no field %q in (%s)", name, v.Type())
+		}
+		if !f.CanInterface() {
+			f = reflect.NewAt(f.Type(), unsafe.Pointer(f.UnsafeAddr())).Elem()
+		}
+		v = f
+	}
+	return v, ""
+}
+
+// field resolves path and reports a test failure if it cannot be resolved.
+func (a *StructAssertion) field(path string, msg ...interface{}) (reflect.Value, bool) {
+	a.t.Helper()
+	if a.broken {
+		return reflect.Value{}, false
+	}
+	v, problem := a.lookup(path)
+	if problem != "" {
+		str := fmt.Sprintf(`field not found:
+ struct: %s
+  field: %s
+  error: %s`, a.root, a.join(path), problem)
+		fail(a.t, str, msg...)
+		return reflect.Value{}, false
+	}
+	return v, true
+}
+
+// Field returns a StructAssertion for the struct at the given field path,
+// so that nested fields can be asserted relative to it.
+func (a *StructAssertion) Field(path string, msg ...interface{}) *StructAssertion {
+	a.t.Helper()
+	sub := &StructAssertion{t: a.t, root: a.root, path: a.join(path), broken: true}
+	v, ok := a.field(path, msg...)
+	if !ok {
+		return sub
+	}
+	for (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) && !v.IsNil() {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		str := fmt.Sprintf(`field is not a struct:
+ struct: %s
+  field: %s
+    got: (%s) %v`, a.root, sub.path, v.Type(), show(a.t, v))
+		fail(a.t, str, msg...)
+		return sub
+	}
+	sub.v, sub.broken = v, false
+	return sub
+}
+
+// FieldEqual reports a test failure if the field at the given path is not
+// deeply equal to expect.
+func (a *StructAssertion) FieldEqual(path string, expect interface{}, msg ...interface{}) *StructAssertion {
+	a.t.Helper()
+	defer track(a.t, a.v)()
+	v, ok := a.field(path, msg...)
+	if !ok {
+		return a
+	}
+	got := v.Interface()
+	if equal, ok := equalValues(a.t, got, expect, msg...); ok && !equal {
+		str := fmt.Sprintf(`field not equal:
+ struct: %s
+  field: %s
+    got: (%T) %v
+ expect: (%T) %v`, a.root, a.join(path), got, show(a.t, got), expect, show(a.t, expect))
+		failValues(a.t, got, expect, str, msg...)
+	}
+	return a
+}
+
+// Fields reports a test failure for every field path in expect whose field
+// is not deeply equal to the corresponding value. Paths are checked in
+// sorted order so that failures are reported deterministically.
+func (a *StructAssertion) Fields(expect map[string]interface{}, msg ...interface{}) *StructAssertion {
+	a.t.Helper()
+	defer track(a.t, a.v)()
+	paths := make([]string, 0, len(expect))
+	for path := range expect {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		a.FieldEqual(path, expect[path], msg...)
+	}
+	return a
+}
+
+// Including returns a StructAssertion whose NoZeroFields only checks the
+// given field paths, relative to this struct, and the fields nested under them.
+func (a *StructAssertion) Including(paths ...string) *StructAssertion {
+	c := *a
+	c.include = append(append([]string(nil), a.include...), paths...)
+	return &c
+}
+
+// Excluding returns a StructAssertion whose NoZeroFields skips the given
+// field paths, relative to this struct, and the fields nested under them.
+func (a *StructAssertion) Excluding(paths ...string) *StructAssertion {
+	c := *a
+	c.exclude = append(append([]string(nil), a.exclude...), paths...)
+	return &c
+}
+
+// NoZeroFields walks the struct and reports a single test failure listing
+// every exported field that still holds its zero value. Nested structs and
+// non-nil pointers to structs are walked recursively; a struct without
+// exported fields, such as time.Time, is checked as a whole. Use Including
+// and Excluding to narrow the set of checked fields.
+func (a *StructAssertion) NoZeroFields(msg ...interface{}) *StructAssertion {
+	a.t.Helper()
+	defer track(a.t, a.v)()
+	if a.broken {
+		return a
+	}
+	var zeros []string
+	seen := map[uintptr]bool{a.v.UnsafeAddr(): true}
+	a.walkZero(a.v, "", seen, &zeros)
+	if len(zeros) > 0 {
+		str := fmt.Sprintf(`struct has zero-valued fields:
+ struct: %s
+  zeros: %s`, a.root, strings.Join(zeros, ", "))
+		fail(a.t, str, msg...)
+	}
+	return a
+}
+
+// walkZero appends the paths of the zero-valued exported fields of the
+// struct v, whose path is prefix, to zeros.
+func (a *StructAssertion) walkZero(v reflect.Value, prefix string, seen map[uintptr]bool, zeros *[]string) {
+	for i := 0; i < v.NumField(); i++ {
+		sf := v.Type().Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		path := sf.Name
+		if prefix != "" {
+			path = prefix + "." + path
+		}
+		if !a.selected(path) {
+			continue
+		}
+		f := v.Field(i)
+		if f.Kind() == reflect.Ptr && !f.IsNil() && f.Elem().Kind() == reflect.Struct {
+			if seen[f.Pointer()] {
+				continue
+			}
+			seen[f.Pointer()] = true
+			f = f.Elem()
+		}
+		if f.Kind() == reflect.Struct && hasExportedFields(f.Type()) {
+			a.walkZero(f, path, seen, zeros)
+			continue
+		}
+		if a.checked(path) && f.IsZero() {
+			*zeros = append(*zeros, a.join(path))
+		}
+	}
+}
+
+// selected reports whether the walk should visit path, which is the case
+// unless it is excluded or lies outside and above every included path.
+func (a *StructAssertion) selected(path string) bool {
+	for _, p := range a.exclude {
+		if underPath(path, p) {
+			return false
+		}
+	}
+	if a.include == nil {
+		return true
+	}
+	for _, p := range a.include {
+		if underPath(path, p) || underPath(p, path) {
+			return true
+		}
+	}
+	return false
+}
+
+// checked reports whether the field at path itself is subject to the check.
+func (a *StructAssertion) checked(path string) bool {
+	if a.include == nil {
+		return true
+	}
+	for _, p := range a.include {
+		if underPath(path, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// underPath reports whether path equals parent or is nested under it.
+func underPath(path, parent string) bool {
+	return path == parent || strings.HasPrefix(path, parent+".")
+}
+
+// hasExportedFields reports whether the struct type t has an exported field.
+func hasExportedFields(t reflect.Type) bool {
+	for i := 0; i < t.NumField(); i++ {
+		if t.Field(i).IsExported() {
+			return true
+		}
+	}
+	return false
+}
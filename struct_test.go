@@ -0,0 +1,167 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/internal/mock"
+)
+
+type Address struct {
+	City string
+	Zip  string
+}
+
+type User struct {
+	Name  string
+	Age   int
+	Addr  *Address
+	Tags  []string
+	note  string
+	Extra interface{}
+}
+
+func TestStruct(t *testing.T) {
+	u := User{
+		Name: "bob",
+		Age:  30,
+		Addr: &Address{City: "Paris", Zip: "75001"},
+		Tags: []string{"admin"},
+		note: "internal",
+	}
+
+	runCase(t, func(g *mock.MockT) {
+		a := assert.ThatStruct(g, u).
+			FieldEqual("Name", "bob").
+			FieldEqual("Addr.City", "Paris").
+			FieldEqual("note", "internal").
+			Fields(map[string]interface{}{
+				"Age":  30,
+				"Tags": []string{"admin"},
+			})
+		a.Field("Addr").FieldEqual("Zip", "75001")
+		assert.ThatStruct(g, &u).FieldEqual("Name", "bob")
+		assert.ThatStruct(g, User{Extra: u}).FieldEqual("Extra.note", "internal")
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`field not equal:
+ struct: assert_test.User
+  field: Addr.City
+    got: (string) Paris
+ expect: (string) Lyon`})
+		g.EXPECT().Error([]interface{}{`field not equal:
+ struct: assert_test.User
+  field: Age
+    got: (int) 30
+ expect: (int64) 30`})
+		g.EXPECT().Error([]interface{}{`field not equal:
+ struct: assert_test.User
+  field: Name
+    got: (string) bob
+ expect: (string) alice`})
+		assert.ThatStruct(g, u).Field("Addr").FieldEqual("City", "Lyon")
+		assert.ThatStruct(g, u).Fields(map[string]interface{}{
+			"Name": "alice",
+			"Age":  int64(30),
+		})
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`field not found:
+ struct: *assert_test.User
+  field: Addr.Street
+  error: no field "Street" in (assert_test.Address)`})
+		g.EXPECT().Error([]interface{}{`field not found:
+ struct: *assert_test.User
+  field: Extra.Value
+  error: nil value at "Extra"`})
+		g.EXPECT().Error([]interface{}{`field not found:
+ struct: *assert_test.User
+  field: Name.First
+  error: (string) at "Name" is not a struct`})
+		g.EXPECT().Error([]interface{}{`field is not a struct:
+ struct: *assert_test.User
+  field: Tags
+    got: ([]string) [admin]`})
+		// the checks are independent, they all run
+		a := assert.ThatStruct(assert.New(g).WithContinueChains(true), &u)
+		a.FieldEqual("Addr.Street", "")
+		a.FieldEqual("Extra.Value", nil)
+		a.FieldEqual("Name.First", "")
+		a.Field("Tags").FieldEqual("Len", 1)
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`expect struct or pointer to struct:
+    got: (int) 3`})
+		assert.ThatStruct(g, 3).FieldEqual("Name", "bob")
+	})
+}
+
+type Config struct {
+	Host    string
+	Port    int
+	Timeout time.Duration
+	Started time.Time
+	TLS     *TLSConfig
+	DB      struct {
+		DSN      string
+		MaxConns int
+	}
+	Parent *Config
+}
+
+type TLSConfig struct {
+	Cert string
+	Key  string
+}
+
+func TestStruct_NoZeroFields(t *testing.T) {
+	c := Config{Host: "localhost", Port: 8080, TLS: &TLSConfig{Cert: "cert.pem"}}
+	c.DB.DSN = "postgres://"
+	c.Parent = &c
+
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`struct has zero-valued fields:
+ struct: *assert_test.Config
+  zeros: Timeout, Started, TLS.Key, DB.MaxConns`})
+		assert.ThatStruct(g, &c).NoZeroFields()
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`struct has zero-valued fields:
+ struct: assert_test.Config
+  zeros: TLS.Key`})
+		assert.ThatStruct(g, c).Including("Host", "TLS", "DB.DSN").NoZeroFields()
+	})
+	runCase(t, func(g *mock.MockT) {
+		assert.ThatStruct(g, c).Excluding("Timeout", "Started", "TLS.Key", "DB.MaxConns", "Parent").NoZeroFields()
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`struct has zero-valued fields:
+ struct: assert_test.Config
+  zeros: TLS.Key
+message: tls must be configured`})
+		assert.ThatStruct(g, c).Field("TLS").NoZeroFields("tls must be configured")
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`struct has zero-valued fields:
+ struct: assert_test.Config
+  zeros: TLS, Parent`})
+		assert.ThatStruct(g, Config{Host: "h"}).Including("Host", "TLS", "Parent").NoZeroFields()
+	})
+}
@@ -0,0 +1,137 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/lvan100/go-assert/internal"
+)
+
+// subtestRunner returns a function running f as a subtest named name of t,
+// or of the first test handler t wraps, that has a method
+//
+//	Run(name string, f func(sub S)) bool
+//
+// for a test handler type S, as *testing.T and *testing.B have. The method
+// is called through reflection so that this package, which also serves
+// non-test code through package check, does not import testing.
+func subtestRunner(t internal.T) (func(name string, f func(sub internal.T)), bool) {
+	for {
+		if run, ok := runMethod(reflect.ValueOf(t).MethodByName("Run")); ok {
+			return run, true
+		}
+		u, ok := t.(interface{ Unwrap() internal.T })
+		if !ok {
+			return nil, false
+		}
+		t = u.Unwrap()
+	}
+}
+
+var tType = reflect.TypeFor[internal.T]()
+
+// runMethod adapts m if it is a Run method running subtests, see
+// subtestRunner.
+func runMethod(m reflect.Value) (func(name string, f func(sub internal.T)), bool) {
+	if !m.IsValid() {
+		return nil, false
+	}
+	typ := m.Type()
+	if typ.NumIn() != 2 || typ.In(0).Kind() != reflect.String ||
+		typ.NumOut() != 1 || typ.Out(0).Kind() != reflect.Bool {
+		return nil, false
+	}
+	fn := typ.In(1)
+	if fn.Kind() != reflect.Func || fn.NumIn() != 1 || fn.NumOut() != 0 || !fn.In(0).Implements(tType) {
+		return nil, false
+	}
+	return func(name string, f func(sub internal.T)) {
+		m.Call([]reflect.Value{
+			reflect.ValueOf(name),
+			reflect.MakeFunc(fn, func(args []reflect.Value) []reflect.Value {
+				f(args[0].Interface().(internal.T))
+				return nil
+			}),
+		})
+	}, true
+}
+
+// Table runs fn for every case of a table-driven test, each in its own
+// subtest named after the Name field of the case, if it has a non-empty
+// one, or after its index otherwise. The failures of the assertions fn
+// makes through its test handler are labeled with the index and name of
+// the case:
+//
+//	assert.Table(t, cases, func(t assert.T, c testCase) {
+//		assert.That(t, parse(c.In)).Equal(c.Want)
+//	})
+//
+// The subtests assert with the settings of the Asserters wrapping t. Test
+// handlers that cannot run subtests, such as Collector, run the cases
+// one after another instead.
+func Table[C any](t T, cases []C, fn func(t T, c C)) {
+	t.Helper()
+	run, ok := subtestRunner(t)
+	for i, c := range cases {
+		label := fmt.Sprintf("case %d", i)
+		name := caseName(c)
+		if name != "" {
+			label += fmt.Sprintf(" %q", name)
+		} else {
+			name = label
+		}
+		if !ok {
+			fn(New(t).WithContext("%s", label), c)
+			continue
+		}
+		run(name, func(sub internal.T) {
+			sub.Helper()
+			fn(New(rebase(t, sub)).WithContext("%s", label), c)
+		})
+	}
+}
+
+// rebase returns copies of the Asserters wrapping t, with their settings,
+// wrapped around sub instead, so that the assertions of a subtest of t are
+// made as those of t.
+func rebase(t internal.T, sub internal.T) internal.T {
+	chain := asserterOf(t)
+	for i := len(chain) - 1; i >= 0; i-- {
+		a := *chain[i]
+		a.t = sub
+		sub = &a
+	}
+	return sub
+}
+
+// caseName returns the Name field of the struct, or pointer to struct, c,
+// or "" if it has no such string field.
+func caseName(c interface{}) string {
+	v := reflect.ValueOf(c)
+	for v.Kind() == reflect.Ptr && !v.IsNil() {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return ""
+	}
+	if f := v.FieldByName("Name"); f.IsValid() && f.Kind() == reflect.String {
+		return f.String()
+	}
+	return ""
+}
@@ -0,0 +1,59 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/internal/mock"
+)
+
+func TestTable(t *testing.T) {
+	type testCase struct {
+		Name string
+		In   string
+		Want string
+	}
+	cases := []testCase{
+		{Name: "lower", In: "abc", Want: "ABC"},
+		{In: "Go", Want: "GO"},
+	}
+	var names []string
+	assert.Table(t, cases, func(t assert.T, c testCase) {
+		names = append(names, t.Name())
+		assert.That(t, strings.ToUpper(c.In)).Equal(c.Want)
+	})
+	assert.That(t, names).Equal([]string{"TestTable/lower", "TestTable/case_1"})
+
+	// the subtests keep the settings of the parent Asserter
+	a := assert.New(t).WithCmpOptions(cmpopts.EquateApprox(0, 0.01))
+	assert.Table(a, []float64{1.001, 0.999}, func(t assert.T, got float64) {
+		assert.That(t, got).Equal(1.0)
+	})
+
+	// without subtests, the cases run inline
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`case 0 "lower": got (string) ABC but expect (string) abc`})
+		g.EXPECT().Error([]interface{}{`case 1: got (string) GO but expect (string) Go`})
+		assert.Table(g, cases, func(t assert.T, c testCase) {
+			assert.That(t, strings.ToUpper(c.In)).Equal(c.In)
+		})
+	})
+}
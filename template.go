@@ -0,0 +1,194 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"fmt"
+	"regexp"
+	"runtime"
+	"strings"
+	"sync"
+	"text/template"
+
+	"github.com/lvan100/go-assert/internal"
+)
+
+// AnyOp is the operation name of a message template that applies to every
+// assertion without a template of its own.
+const AnyOp = "*"
+
+// Failure describes a failed assertion. It is the data passed to message
+// templates, see SetMessageTemplate.
+type Failure struct {
+	// Op is the assertion that failed, named after the exported function or
+	// method that was called, e.g. "Nil", "ThatAssertion.Equal" or
+	// "StringAssertion.HasPrefix".
+	Op string
+
+	// Text is the default failure text, without the user message.
+	Text string
+
+	// Fields holds the labeled lines of Text by label, e.g. "got",
+	// "expect", "path" or "error".
+	Fields map[string]string
+
+	// Got and Expect are the compared values for assertions that compare
+	// two values, and nil otherwise. The Expect of Between and NotBetween
+	// is the array of the lower and upper bounds.
+	Got    interface{}
+	Expect interface{}
+
+	// Message is the user message joined with ", ".
+	Message string
+
+	// Context lists the descriptions set with Asserter.WithContext,
+	// outermost first. They are prefixed to the failure text.
+	Context []string
+
+	// Phases lists the labels of the phases set with Phase, outermost
+	// first. The failure text is indented under them.
+	Phases []string
+
+	// Caller is the "file.go:123" location of the assertion call site, the
+	// first caller outside this package and its subpackages.
+	Caller string
+
+	// Artifacts is the directory the full values of the failure were
+	// written to, see Settings.ArtifactsDir, or "" if none.
+	Artifacts string
+
+	// Output is the complete failure message as reported to the test. It
+	// is set for reporters only.
+	Output string
+}
+
+var templates = struct {
+	sync.RWMutex
+	m map[string]*template.Template
+}{m: make(map[string]*template.Template)}
+
+// SetMessageTemplate overrides the failure text of the assertion op with a
+// text/template executed on a Failure, e.g. to localize messages or to use
+// a team's preferred wording. op is a Failure.Op value, or AnyOp for all
+// assertions without a template of their own. The user message is still
+// appended after the template output. In templates, "show" prints a value
+// like the default messages do and "type" prints its type:
+//
+//	defer assert.SetMessageTemplate("ThatAssertion.Equal",
+//		"want {{show .Expect}}, have {{show .Got}}")()
+//
+// It panics if text is not a valid template. The returned function restores
+// the previous template.
+func SetMessageTemplate(op, text string) (restore func()) {
+	tmpl := template.Must(template.New(op).Funcs(templateFuncs(nil)).Parse(text))
+	templates.Lock()
+	defer templates.Unlock()
+	prev, ok := templates.m[op]
+	templates.m[op] = tmpl
+	return func() {
+		templates.Lock()
+		defer templates.Unlock()
+		if ok {
+			templates.m[op] = prev
+		} else {
+			delete(templates.m, op)
+		}
+	}
+}
+
+// templateFuncs returns the functions available in message templates.
+func templateFuncs(t internal.T) template.FuncMap {
+	return template.FuncMap{
+		"show": func(v interface{}) string { return fmt.Sprint(show(t, v)) },
+		"type": func(v interface{}) string { return fmt.Sprintf("%T", v) },
+	}
+}
+
+// templateFor returns the message template for op, if any.
+func templateFor(op string) (*template.Template, bool) {
+	templates.RLock()
+	defer templates.RUnlock()
+	if tmpl, ok := templates.m[op]; ok {
+		return tmpl, true
+	}
+	tmpl, ok := templates.m[AnyOp]
+	return tmpl, ok
+}
+
+// applyTemplate returns the failure text of f, rendered with the template
+// registered for its operation if there is one.
+func applyTemplate(t internal.T, f *Failure) string {
+	tmpl, ok := templateFor(f.Op)
+	if !ok {
+		return f.Text
+	}
+	tmpl, err := tmpl.Clone()
+	if err == nil {
+		var sb strings.Builder
+		if err = tmpl.Funcs(templateFuncs(t)).Execute(&sb, f); err == nil {
+			return sb.String()
+		}
+	}
+	return f.Text + "\n   note: message template failed: " + err.Error()
+}
+
+var fieldLine = regexp.MustCompile(`(?m)^ *([a-z]+): (.*)$`)
+
+// parseFields returns the labeled lines of a failure text by label.
+func parseFields(text string) map[string]string {
+	fields := make(map[string]string)
+	for _, m := range fieldLine.FindAllStringSubmatch(text, -1) {
+		if _, ok := fields[m[1]]; !ok {
+			fields[m[1]] = m[2]
+		}
+	}
+	return fields
+}
+
+const pkgPath = "github.com/lvan100/go-assert."
+
+// callerOp returns the name of the outermost function of this package on
+// the stack of its caller, which is the assertion called by the test.
+func callerOp() string {
+	pc := make([]uintptr, 32)
+	n := runtime.Callers(3, pc)
+	frames := runtime.CallersFrames(pc[:n])
+	op := ""
+	for {
+		frame, more := frames.Next()
+		name, ok := strings.CutPrefix(frame.Function, pkgPath)
+		if !ok {
+			break
+		}
+		op = name
+		if !more {
+			break
+		}
+	}
+	return opName(op)
+}
+
+var closureSuffix = regexp.MustCompile(`(\.func\d+)+$`)
+
+// opName turns a function name such as "(*ThatAssertion).Equal" or
+// "HasDetail[...]" into an operation name.
+func opName(name string) string {
+	name = closureSuffix.ReplaceAllString(name, "")
+	name = strings.ReplaceAll(name, "[...]", "")
+	name = strings.ReplaceAll(name, "(*", "")
+	return strings.ReplaceAll(name, ")", "")
+}
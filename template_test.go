@@ -0,0 +1,72 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert_test
+
+import (
+	"testing"
+
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/internal/mock"
+)
+
+func TestSetMessageTemplate(t *testing.T) {
+	t.Run("values", func(t *testing.T) {
+		defer assert.SetMessageTemplate("ThatAssertion.Equal",
+			"want {{show .Expect}}, have {{show .Got}} ({{.Op}})")()
+		runCase(t, func(g *mock.MockT) {
+			g.EXPECT().Error([]interface{}{"want 2, have 1 (ThatAssertion.Equal)\nmessage: sum"})
+			assert.That(g, 1).Equal(2, "sum")
+		})
+		runCase(t, func(g *mock.MockT) {
+			g.EXPECT().Error([]interface{}{"got (int) 1 but expect not (int) 1"})
+			assert.That(g, 1).NotEqual(1)
+		})
+	})
+	t.Run("fields", func(t *testing.T) {
+		defer assert.SetMessageTemplate("StringAssertion.HasPrefix",
+			"préfixe manquant : {{.Fields.got}} ne commence pas par {{.Fields.expect}}")()
+		runCase(t, func(g *mock.MockT) {
+			g.EXPECT().Error([]interface{}{`préfixe manquant : (string) "hello" ne commence pas par to have prefix "x"`})
+			assert.ThatString(g, "hello").HasPrefix("x")
+		})
+	})
+	t.Run("any", func(t *testing.T) {
+		defer assert.SetMessageTemplate(assert.AnyOp, "[{{.Op}}] {{.Text}}")()
+		runCase(t, func(g *mock.MockT) {
+			g.EXPECT().Error([]interface{}{"[Nil] got (int) 1 but expect nil"})
+			assert.Nil(g, 1)
+		})
+		runCase(t, func(g *mock.MockT) {
+			g.EXPECT().Error([]interface{}{"[NumberAssertion.GreaterThan] got (int) 1 but expect greater than (int) 2"})
+			assert.ThatNumber(g, 1).GreaterThan(2)
+		})
+	})
+	t.Run("error", func(t *testing.T) {
+		defer assert.SetMessageTemplate("True", "{{.Missing.Field}}")()
+		runCase(t, func(g *mock.MockT) {
+			g.EXPECT().Error([]interface{}{"got false but expect true\n" +
+				"   note: message template failed: template: True:1:10: executing \"True\" at <.Missing.Field>: can't evaluate field Missing in type *assert.Failure"})
+			assert.True(g, false)
+		})
+	})
+	t.Run("invalid", func(t *testing.T) {
+		defer func() {
+			assert.NotNil(t, recover())
+		}()
+		assert.SetMessageTemplate("True", "{{")
+	})
+}
@@ -0,0 +1,61 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"github.com/lvan100/go-assert/internal"
+)
+
+// TypedAssertion encapsulates a value of type T and a test handler for
+// making assertions whose expected values must have the same type, so that
+// comparing, say, an int with an int64 does not compile instead of failing
+// at test time, as it does with That.
+type TypedAssertion[T any] struct {
+	t internal.T
+	v T
+}
+
+// ThatT returns a TypedAssertion for the given testing object and value.
+func ThatT[T any](t internal.T, v T) *TypedAssertion[T] {
+	return &TypedAssertion[T]{
+		t: t,
+		v: v,
+	}
+}
+
+// Equal asserts that the value is deeply equal to expect, see
+// ThatAssertion.Equal. It reports an error if the values are not deeply equal.
+func (a *TypedAssertion[T]) Equal(expect T, msg ...interface{}) (ok bool) {
+	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
+	return equal(a.t, a.v, expect, msg...)
+}
+
+// NotEqual asserts that the value is not deeply equal to expect.
+// It reports an error if the values are deeply equal.
+func (a *TypedAssertion[T]) NotEqual(expect T, msg ...interface{}) (ok bool) {
+	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
+	return notEqual(a.t, a.v, expect, msg...)
+}
+
+// Satisfies asserts that pred holds for the value, see ThatAssertion.Satisfies.
+func (a *TypedAssertion[T]) Satisfies(pred func(v T) bool, desc string, msg ...interface{}) (ok bool) {
+	a.t.Helper()
+	defer trackResult(a.t, a.v, &ok)()
+	return satisfies(a.t, a.v, pred(a.v), desc, msg...)
+}
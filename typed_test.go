@@ -0,0 +1,53 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/internal/mock"
+)
+
+func TestThatT(t *testing.T) {
+	type point struct{ X, Y int }
+	runCase(t, func(g *mock.MockT) {
+		assert.ThatT(g, int64(3)).Equal(3)
+		assert.ThatT(g, []string{"a"}).Equal([]string{"a"})
+		assert.ThatT(g, point{1, 2}).NotEqual(point{2, 1})
+		assert.ThatT(g, "a").Not().Equal("b")
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"got (int64) 3 but expect (int64) 4"})
+		g.EXPECT().Error([]interface{}{"got (string) a but expect not (string) a"})
+		assert.False(t, assert.ThatT(g, int64(3)).Equal(4))
+		assert.False(t, assert.ThatT(g, "a").NotEqual("a"))
+	})
+}
+
+func TestThatT_Satisfies(t *testing.T) {
+	runCase(t, func(g *mock.MockT) {
+		assert.ThatT(g, time.Second).Satisfies(func(d time.Duration) bool { return d > 0 }, "a positive duration")
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`value does not satisfy the condition:
+    got: (time.Duration) -1s
+ expect: a positive duration`})
+		assert.ThatT(g, -time.Second).Satisfies(func(d time.Duration) bool { return d > 0 }, "a positive duration")
+	})
+}
@@ -0,0 +1,188 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/lvan100/go-assert/internal"
+)
+
+// URL is the set of URL types accepted by ThatURL.
+type URL interface {
+	string | *url.URL
+}
+
+// URLAssertion encapsulates a URL and a test handler for asserting on the
+// URL component-wise, without parsing it by hand.
+type URLAssertion struct {
+	t internal.T
+	u *url.URL
+}
+
+// ThatURL returns a URLAssertion for the given testing object and URL,
+// which may be a *url.URL or a string. A test failure is reported if the
+// string cannot be parsed.
+func ThatURL[U URL](t internal.T, u U) *URLAssertion {
+	t.Helper()
+	a := &URLAssertion{t: chain(t)}
+	switch v := any(u).(type) {
+	case *url.URL:
+		a.u = v
+	case string:
+		p, err := url.Parse(v)
+		if err != nil {
+			str := fmt.Sprintf(`invalid URL:
+    got: %q
+  error: %v`, v, err)
+			fail(a.t, str)
+			return a
+		}
+		a.u = p
+	}
+	return a
+}
+
+// valid reports a test failure if there is no URL to assert on.
+func (a *URLAssertion) valid(msg ...interface{}) bool {
+	a.t.Helper()
+	if a.u == nil {
+		fail(a.t, "expect not nil URL", msg...)
+		return false
+	}
+	return true
+}
+
+// component reports a test failure if the named component of the URL is
+// not equal to the expected value.
+func (a *URLAssertion) component(name string, got, expect string, msg ...interface{}) *URLAssertion {
+	a.t.Helper()
+	if got != expect {
+		str := fmt.Sprintf(`URL %s mismatch:
+    url: %q
+    got: %q
+ expect: %q`, name, a.u.String(), got, expect)
+		fail(a.t, str, msg...)
+	}
+	return a
+}
+
+// Equal reports a test failure if the URL is not equal to the expected URL string.
+func (a *URLAssertion) Equal(expect string, msg ...interface{}) *URLAssertion {
+	a.t.Helper()
+	defer track(a.t, nil)()
+	if !a.valid(msg...) {
+		return a
+	}
+	if got := a.u.String(); got != expect {
+		str := fmt.Sprintf(`URLs not equal:
+    got: %q
+ expect: %q`, got, expect)
+		fail(a.t, str, msg...)
+	}
+	return a
+}
+
+// SchemeIs reports a test failure if the URL scheme is not equal to the expected scheme.
+func (a *URLAssertion) SchemeIs(scheme string, msg ...interface{}) *URLAssertion {
+	a.t.Helper()
+	defer track(a.t, nil)()
+	if !a.valid(msg...) {
+		return a
+	}
+	return a.component("scheme", a.u.Scheme, scheme, msg...)
+}
+
+// HostIs reports a test failure if the URL host, including any port, is not
+// equal to the expected host.
+func (a *URLAssertion) HostIs(host string, msg ...interface{}) *URLAssertion {
+	a.t.Helper()
+	defer track(a.t, nil)()
+	if !a.valid(msg...) {
+		return a
+	}
+	return a.component("host", a.u.Host, host, msg...)
+}
+
+// PathIs reports a test failure if the URL path is not equal to the expected path.
+func (a *URLAssertion) PathIs(path string, msg ...interface{}) *URLAssertion {
+	a.t.Helper()
+	defer track(a.t, nil)()
+	if !a.valid(msg...) {
+		return a
+	}
+	return a.component("path", a.u.Path, path, msg...)
+}
+
+// PathMatches reports a test failure if the URL path does not match the given regular expression.
+func (a *URLAssertion) PathMatches(expr string, msg ...interface{}) *URLAssertion {
+	a.t.Helper()
+	defer track(a.t, nil)()
+	if !a.valid(msg...) {
+		return a
+	}
+	if ok, err := matchString(expr, a.u.Path); !ok {
+		str := fmt.Sprintf(`URL path does not match the pattern:
+    url: %q
+    got: %q
+ expect: to match regex %q`, a.u.String(), a.u.Path, expr)
+		if err != nil {
+			str += fmt.Sprintf("\n  error: %v", err)
+		}
+		fail(a.t, str, msg...)
+	}
+	return a
+}
+
+// FragmentIs reports a test failure if the URL fragment is not equal to the expected fragment.
+func (a *URLAssertion) FragmentIs(fragment string, msg ...interface{}) *URLAssertion {
+	a.t.Helper()
+	defer track(a.t, nil)()
+	if !a.valid(msg...) {
+		return a
+	}
+	return a.component("fragment", a.u.Fragment, fragment, msg...)
+}
+
+// HasQueryParam reports a test failure if the query parameter name is absent.
+func (a *URLAssertion) HasQueryParam(name string, msg ...interface{}) *URLAssertion {
+	a.t.Helper()
+	defer track(a.t, nil)()
+	if !a.valid(msg...) {
+		return a
+	}
+	if !a.u.Query().Has(name) {
+		str := fmt.Sprintf(`query parameter not found:
+  param: %q
+    got: %q`, name, a.u.RawQuery)
+		fail(a.t, str, msg...)
+	}
+	return a
+}
+
+// QueryParam returns a StringAssertion over the first value of the query
+// parameter name. A test failure is reported if the parameter is absent.
+func (a *URLAssertion) QueryParam(name string, msg ...interface{}) *StringAssertion {
+	a.t.Helper()
+	defer track(a.t, nil)()
+	if !a.valid(msg...) {
+		return ThatString(a.t, "")
+	}
+	a.HasQueryParam(name, msg...)
+	return ThatString(a.t, a.u.Query().Get(name))
+}
@@ -0,0 +1,83 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/internal/mock"
+)
+
+func TestURL(t *testing.T) {
+	const raw = "https://example.com:8443/cb/42?code=abc&state=xyz#top"
+
+	runCase(t, func(g *mock.MockT) {
+		assert.ThatURL(g, raw).
+			Equal(raw).
+			SchemeIs("https").
+			HostIs("example.com:8443").
+			PathIs("/cb/42").
+			PathMatches(`^/cb/\d+$`).
+			FragmentIs("top").
+			HasQueryParam("state").
+			QueryParam("code").Equal("abc")
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`URL host mismatch:
+    url: "https://example.com:8443/cb/42?code=abc&state=xyz#top"
+    got: "example.com:8443"
+ expect: "example.com"`})
+		g.EXPECT().Error([]interface{}{`URL path does not match the pattern:
+    url: "https://example.com:8443/cb/42?code=abc&state=xyz#top"
+    got: "/cb/42"
+ expect: to match regex "^/login"`})
+		g.EXPECT().Error([]interface{}{`query parameter not found:
+  param: "token"
+    got: "code=abc&state=xyz"`})
+		assert.ThatURL(assert.New(g).WithContinueChains(true), raw).HostIs("example.com").PathMatches("^/login").HasQueryParam("token")
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`invalid URL:
+    got: "%zz"
+  error: parse "%zz": invalid URL escape "%zz"`})
+		assert.ThatURL(g, "%zz").SchemeIs("http")
+	})
+}
+
+func TestURL_Integration(t *testing.T) {
+	redirect := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "https://auth.example.com/login?next=%2Fhome", http.StatusFound)
+	})
+
+	runCase(t, func(g *mock.MockT) {
+		a := assert.ServeHTTP(g, redirect, httptest.NewRequest(http.MethodGet, "/home", nil)).StatusIs(http.StatusFound)
+		a.Location().HostIs("auth.example.com").PathIs("/login").QueryParam("next").Equal("/home")
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`invalid Location header:
+    got: ""
+  error: http: no Location header in response`})
+		assert.ServeHTTP(g, http.NotFoundHandler(), httptest.NewRequest(http.MethodGet, "/", nil)).Location().PathIs("/")
+	})
+	runCase(t, func(g *mock.MockT) {
+		req := httptest.NewRequest(http.MethodGet, "/callback?code=abc", nil)
+		assert.ThatRequest(g, req).RequestURL().PathIs("/callback").QueryParam("code").Equal("abc")
+	})
+}
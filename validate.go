@@ -0,0 +1,214 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"fmt"
+	"net/mail"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/lvan100/go-assert/internal"
+)
+
+// FieldViolation describes a struct field that violates a validation rule.
+type FieldViolation struct {
+	Field   string // dotted field path, e.g. "Addr.City"
+	Rule    string // violated rule, e.g. "min=3"
+	Message string // human-readable description
+}
+
+// Validator validates v and returns every violated rule, or nil if v is valid.
+type Validator func(v interface{}) []FieldViolation
+
+var (
+	validatorMu sync.RWMutex
+	validator   Validator = TagValidator
+)
+
+// SetValidator replaces the validator used by Valid, e.g. with an adapter
+// around a third-party validation library, and returns the previous one so
+// that it can be restored. Passing nil restores TagValidator.
+func SetValidator(v Validator) Validator {
+	if v == nil {
+		v = TagValidator
+	}
+	validatorMu.Lock()
+	defer validatorMu.Unlock()
+	prev := validator
+	validator = v
+	return prev
+}
+
+// Valid reports a test failure listing every field of obj that violates a
+// validation rule of the current validator, see SetValidator.
+func Valid(t internal.T, obj interface{}, msg ...interface{}) bool {
+	t.Helper()
+	defer track(t, obj)()
+	validatorMu.RLock()
+	validate := validator
+	validatorMu.RUnlock()
+	violations := validate(obj)
+	if len(violations) == 0 {
+		return true
+	}
+	var sb strings.Builder
+	fmt.Fprintf(&sb, `validation failed:
+ struct: %T
+ errors:`, obj)
+	for _, v := range violations {
+		fmt.Fprintf(&sb, "\n    - %s: %s (%s)", v.Field, v.Message, v.Rule)
+	}
+	fail(t, sb.String(), msg...)
+	return false
+}
+
+// TagValidator is the default Validator. It checks the rules declared in
+// the `validate` or, failing that, the `binding` struct tag of each
+// exported field, walking nested structs recursively. Rules are separated
+// by commas; supported rules are required, omitempty, min=N, max=N, len=N,
+// email and oneof=a b c. For strings, slices and maps min, max and len
+// apply to the length, for numbers to the value.
+func TagValidator(v interface{}) []FieldViolation {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return []FieldViolation{{
+			Field:   "",
+			Rule:    "struct",
+			Message: fmt.Sprintf("(%T) is not a struct", v),
+		}}
+	}
+	var out []FieldViolation
+	validateStruct(rv, "", &out)
+	return out
+}
+
+func validateStruct(v reflect.Value, prefix string, out *[]FieldViolation) {
+	for i := 0; i < v.NumField(); i++ {
+		sf := v.Type().Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		path := sf.Name
+		if prefix != "" {
+			path = prefix + "." + path
+		}
+		f := v.Field(i)
+		tag, ok := sf.Tag.Lookup("validate")
+		if !ok {
+			tag = sf.Tag.Get("binding")
+		}
+		if tag != "" && tag != "-" {
+			validateField(f, path, strings.Split(tag, ","), out)
+		}
+		for f.Kind() == reflect.Ptr && !f.IsNil() {
+			f = f.Elem()
+		}
+		if f.Kind() == reflect.Struct && hasExportedFields(f.Type()) {
+			validateStruct(f, path, out)
+		}
+	}
+}
+
+func validateField(f reflect.Value, path string, rules []string, out *[]FieldViolation) {
+	if slices.Contains(rules, "omitempty") && f.IsZero() {
+		return
+	}
+	elem := f
+	for elem.Kind() == reflect.Ptr && !elem.IsNil() {
+		elem = elem.Elem()
+	}
+	for _, rule := range rules {
+		name, param, _ := strings.Cut(rule, "=")
+		v := elem
+		if name == "required" {
+			v = f
+		} else if v.Kind() == reflect.Ptr {
+			continue // nil pointers are only subject to required
+		}
+		if msg := checkRule(v, name, param); msg != "" {
+			*out = append(*out, FieldViolation{Field: path, Rule: rule, Message: msg})
+		}
+	}
+}
+
+// checkRule returns a description of the violation, or "" if f satisfies the rule.
+func checkRule(f reflect.Value, name, param string) string {
+	switch name {
+	case "omitempty":
+		return ""
+	case "required":
+		if f.IsZero() {
+			return "is required"
+		}
+		return ""
+	case "email":
+		s := fmt.Sprint(f.Interface())
+		if a, err := mail.ParseAddress(s); err != nil || a.Address != s {
+			return fmt.Sprintf("%q is not a valid email address", s)
+		}
+		return ""
+	case "oneof":
+		s := fmt.Sprint(f.Interface())
+		if !slices.Contains(strings.Fields(param), s) {
+			return fmt.Sprintf("%q is not one of [%s]", s, param)
+		}
+		return ""
+	case "min", "max", "len":
+		n, err := strconv.ParseFloat(param, 64)
+		if err != nil {
+			return fmt.Sprintf("invalid rule parameter %q", param)
+		}
+		what, got, ok := measure(f)
+		if !ok {
+			return fmt.Sprintf("rule not applicable to %s", f.Type())
+		}
+		switch {
+		case name == "min" && got < n:
+			return fmt.Sprintf("%s %v is less than %v", what, got, n)
+		case name == "max" && got > n:
+			return fmt.Sprintf("%s %v is greater than %v", what, got, n)
+		case name == "len" && got != n:
+			return fmt.Sprintf("%s %v is not %v", what, got, n)
+		}
+		return ""
+	default:
+		return "unsupported rule"
+	}
+}
+
+// measure returns the quantity that min, max and len apply to.
+func measure(f reflect.Value) (string, float64, bool) {
+	switch f.Kind() {
+	case reflect.String, reflect.Slice, reflect.Map, reflect.Array, reflect.Chan:
+		return "length", float64(f.Len()), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return "value", float64(f.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return "value", float64(f.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return "value", f.Float(), true
+	default:
+		return "", 0, false
+	}
+}
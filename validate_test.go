@@ -0,0 +1,95 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert_test
+
+import (
+	"testing"
+
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/internal/mock"
+)
+
+type SignUp struct {
+	Name    string   `validate:"required,min=3,max=8"`
+	Email   string   `binding:"required,email"`
+	Age     int      `validate:"min=18"`
+	Role    string   `validate:"omitempty,oneof=admin user"`
+	Tags    []string `validate:"max=2"`
+	Invite  *string  `validate:"omitempty,len=6"`
+	Profile struct {
+		Bio string `validate:"max=10"`
+	}
+}
+
+func TestValid(t *testing.T) {
+	code := "ABC123"
+	ok := SignUp{Name: "bob", Email: "bob@example.com", Age: 20, Role: "user", Invite: &code}
+
+	runCase(t, func(g *mock.MockT) {
+		assert.Valid(g, ok)
+		assert.Valid(g, &ok)
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`validation failed:
+ struct: assert_test.SignUp
+ errors:
+    - Name: length 2 is less than 3 (min=3)
+    - Email: "bob" is not a valid email address (email)
+    - Age: value 3 is less than 18 (min=18)
+    - Role: "root" is not one of [admin user] (oneof=admin user)
+    - Tags: length 3 is greater than 2 (max=2)
+    - Invite: length 2 is not 6 (len=6)
+    - Profile.Bio: length 11 is greater than 10 (max=10)
+message: sign up form`})
+		bad := ok
+		bad.Name = "bo"
+		bad.Email = "bob"
+		bad.Age = 3
+		bad.Role = "root"
+		bad.Tags = []string{"a", "b", "c"}
+		short := "AB"
+		bad.Invite = &short
+		bad.Profile.Bio = "hello world"
+		assert.Valid(g, bad, "sign up form")
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`validation failed:
+ struct: assert_test.SignUp
+ errors:
+    - Name: is required (required)
+    - Name: length 0 is less than 3 (min=3)
+    - Email: is required (required)
+    - Email: "" is not a valid email address (email)
+    - Age: value 0 is less than 18 (min=18)`})
+		assert.Valid(g, SignUp{})
+	})
+}
+
+func TestSetValidator(t *testing.T) {
+	prev := assert.SetValidator(func(v interface{}) []assert.FieldViolation {
+		return []assert.FieldViolation{{Field: "Name", Rule: "custom", Message: "always fails"}}
+	})
+	defer assert.SetValidator(prev)
+
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`validation failed:
+ struct: assert_test.SignUp
+ errors:
+    - Name: always fails (custom)`})
+		assert.Valid(g, SignUp{})
+	})
+}
@@ -0,0 +1,121 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"fmt"
+	"reflect"
+	"unicode/utf8"
+
+	"github.com/lvan100/go-assert/internal"
+)
+
+// Verbosity controls how much of a value is printed in failure messages.
+type Verbosity int
+
+const (
+	// VerbosityTruncated prints values on one line and cuts them after
+	// TruncatedValueLen bytes. It is the default.
+	VerbosityTruncated Verbosity = iota
+	// VerbosityCompact prints values on one line and cuts them after
+	// CompactValueLen bytes.
+	VerbosityCompact
+	// VerbosityFull prints values completely, dumping structs, maps and
+	// slices over multiple indented lines.
+	VerbosityFull
+)
+
+// Maximum lengths of a printed value for the truncating verbosity levels.
+const (
+	TruncatedValueLen = 1024
+	CompactValueLen   = 80
+)
+
+// verbosityOf returns the verbosity in effect for t: that of the outermost
+// Asserter setting one, or else the package-level setting.
+func verbosityOf(t internal.T) Verbosity {
+	for _, a := range asserterOf(t) {
+		if a.verbosity != nil {
+			return *a.verbosity
+		}
+	}
+	return currentSettings().Verbosity
+}
+
+// shownValue is a fmt.Formatter that prints a value according to a verbosity.
+type shownValue struct {
+	v         interface{}
+	verbosity Verbosity
+}
+
+// show wraps v for printing in a failure message reported through t.
+// Use it for the %v or %q argument of a value, not for %T.
+func show(t internal.T, v interface{}) fmt.Formatter {
+	if rv, ok := v.(reflect.Value); ok && rv.IsValid() && rv.CanInterface() {
+		v = rv.Interface()
+	}
+	return shownValue{v: v, verbosity: verbosityOf(t)}
+}
+
+// Format implements fmt.Formatter.
+func (s shownValue) Format(f fmt.State, verb rune) {
+	if str, ok := formatted(reflect.ValueOf(s.v)); ok {
+		_, _ = fmt.Fprint(f, str)
+		return
+	}
+	if s.verbosity == VerbosityFull {
+		if verb == 'v' && !f.Flag('#') && isComposite(s.v) {
+			_, _ = fmt.Fprint(f, "\n"+prettyFormat(s.v))
+			return
+		}
+		_, _ = fmt.Fprintf(f, fmt.FormatString(f, verb), s.v)
+		return
+	}
+	limit := TruncatedValueLen
+	if s.verbosity == VerbosityCompact {
+		limit = CompactValueLen
+	}
+	_, _ = fmt.Fprint(f, truncate(fmt.Sprintf(fmt.FormatString(f, verb), s.v), limit))
+}
+
+// isComposite reports whether v is a struct, map, slice or array, or a
+// pointer to one of them.
+func isComposite(v interface{}) bool {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	switch rv.Kind() {
+	case reflect.Struct, reflect.Map, reflect.Slice, reflect.Array:
+		return true
+	default:
+		return false
+	}
+}
+
+// truncate cuts s after limit bytes, at a rune boundary, and notes how many
+// bytes were omitted.
+func truncate(s string, limit int) string {
+	if len(s) <= limit {
+		return s
+	}
+	cut := limit
+	for cut > 0 && !utf8.RuneStart(s[cut]) {
+		cut--
+	}
+	return fmt.Sprintf("%s... (%d more bytes)", s[:cut], len(s)-cut)
+}
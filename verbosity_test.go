@@ -0,0 +1,81 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/internal/mock"
+)
+
+func TestVerbosity(t *testing.T) {
+	long := strings.Repeat("a", 100)
+
+	t.Run("truncated", func(t *testing.T) {
+		runCase(t, func(g *mock.MockT) {
+			g.EXPECT().Error([]interface{}{"strings not equal:\n" +
+				`    got: (string) "` + strings.Repeat("a", 1023) + `... (78 more bytes)` + "\n" +
+				` expect: (string) "b"`})
+			assert.ThatString(g, strings.Repeat("a", 1100)).Equal("b")
+		})
+	})
+	t.Run("compact", func(t *testing.T) {
+		runCase(t, func(g *mock.MockT) {
+			g.EXPECT().Error([]interface{}{"strings not equal:\n" +
+				`    got: (string) "` + strings.Repeat("a", 79) + `... (22 more bytes)` + "\n" +
+				` expect: (string) "b"`})
+			a := assert.New(g).WithVerbosity(assert.VerbosityCompact)
+			assert.ThatString(a, long).Equal("b")
+		})
+	})
+	t.Run("full", func(t *testing.T) {
+		type Point struct{ X, Y int }
+		runCase(t, func(g *mock.MockT) {
+			g.EXPECT().Error([]interface{}{`got (assert_test.Point) 
+assert_test.Point{
+  X: 1,
+  Y: 2,
+} but expect (assert_test.Point) 
+assert_test.Point{
+  X: 1,
+  Y: 3,
+}`})
+			a := assert.New(g).WithVerbosity(assert.VerbosityFull)
+			assert.That(a, Point{1, 2}).Equal(Point{1, 3})
+		})
+	})
+	t.Run("configure", func(t *testing.T) {
+		defer assert.Configure(func(s *assert.Settings) {
+			s.Verbosity = assert.VerbosityCompact
+		})()
+		runCase(t, func(g *mock.MockT) {
+			g.EXPECT().Error([]interface{}{"strings not equal:\n" +
+				`    got: (string) "` + strings.Repeat("a", 79) + `... (22 more bytes)` + "\n" +
+				` expect: (string) "b"`})
+			assert.ThatString(g, long).Equal("b")
+		})
+		runCase(t, func(g *mock.MockT) {
+			g.EXPECT().Error([]interface{}{"strings not equal:\n" +
+				`    got: (string) "` + long + `"` + "\n" +
+				` expect: (string) "b"`})
+			a := assert.New(g).WithVerbosity(assert.VerbosityFull)
+			assert.ThatString(a, long).Equal("b")
+		})
+	})
+}
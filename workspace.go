@@ -0,0 +1,95 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/lvan100/go-assert/internal"
+)
+
+// WorkspaceAssertion is a temporary directory in which the code under test
+// produces files, paired with assertions over those files. Paths passed to
+// its methods are relative to the workspace root and are reported that way
+// in failure messages.
+type WorkspaceAssertion struct {
+	t   internal.T
+	dir string
+}
+
+// Workspace returns a WorkspaceAssertion rooted at a fresh temporary
+// directory obtained from t.TempDir, so it is removed automatically when
+// the test finishes. The test handler must provide a TempDir method, as
+// *testing.T does; otherwise the test fails and Dir returns "".
+func Workspace(t internal.T) *WorkspaceAssertion {
+	t.Helper()
+	ws := &WorkspaceAssertion{t: t}
+	if td, ok := findT[interface{ TempDir() string }](t); ok {
+		ws.dir = td.TempDir()
+	} else {
+		fail(t, fmt.Sprintf("test handler %T does not provide a TempDir method", t))
+	}
+	return ws
+}
+
+// Dir returns the absolute path of the workspace root.
+func (ws *WorkspaceAssertion) Dir() string {
+	return ws.dir
+}
+
+// Path returns the absolute path of the given workspace-relative path.
+func (ws *WorkspaceAssertion) Path(rel string) string {
+	return filepath.Join(ws.dir, filepath.FromSlash(rel))
+}
+
+// WriteFile creates the given workspace-relative file with content,
+// creating parent directories as needed. It reports a test failure if the
+// file cannot be written. It is intended for seeding input fixtures.
+func (ws *WorkspaceAssertion) WriteFile(rel string, content string, msg ...interface{}) *WorkspaceAssertion {
+	ws.t.Helper()
+	path := ws.Path(rel)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		str := fmt.Sprintf(`unable to create directory:
+   path: %q
+  error: %v`, filepath.ToSlash(filepath.Dir(rel)), err)
+		fail(ws.t, str, msg...)
+		return ws
+	}
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		str := fmt.Sprintf(`unable to write file:
+   path: %q
+  error: %v`, rel, err)
+		fail(ws.t, str, msg...)
+	}
+	return ws
+}
+
+// File returns a FileAssertion for the given workspace-relative path.
+func (ws *WorkspaceAssertion) File(rel string) *FileAssertion {
+	return &FileAssertion{
+		t:    ws.t,
+		path: ws.Path(rel),
+		name: rel,
+	}
+}
+
+// FS returns an FSAssertion over the whole workspace.
+func (ws *WorkspaceAssertion) FS() *FSAssertion {
+	return ThatFS(ws.t, os.DirFS(ws.dir))
+}
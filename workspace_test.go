@@ -0,0 +1,70 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/internal/mock"
+	"github.com/lvan100/go-assert/yamlassert"
+)
+
+// tempDirT adds a TempDir method to the mock so that workspaces can be created.
+type tempDirT struct {
+	*mock.MockT
+	dir string
+}
+
+func (t tempDirT) TempDir() string { return t.dir }
+
+func TestWorkspace(t *testing.T) {
+	dir := t.TempDir()
+	runCase(t, func(g *mock.MockT) {
+		ws := assert.Workspace(tempDirT{g, dir})
+		assert.ThatString(t, ws.Dir()).Equal(dir)
+		assert.Nil(t, os.MkdirAll(ws.Path("out"), 0o755))
+		assert.Nil(t, os.WriteFile(filepath.Join(dir, "out", "config.json"), []byte(`{"port": 8080, "debug": true}`), 0o644))
+
+		ws.WriteFile("in/config.yaml", "port: 8080\ndebug: true\n")
+		ws.File("out/config.json").JSONEqual(`{"debug": true, "port": 8080}`)
+		ws.File("in/config.yaml").DecodedEqual(yamlassert.Codec, "debug: true\nport: 8080")
+		ws.FS().DirEntries(".", []string{"in", "out"})
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`JSON structures are not equal:
+   path: "out/config.json"
+    got: (string) "{\"port\": 8080}"
+ expect: (string) "{\"port\": 9090}"`})
+		ws := assert.Workspace(tempDirT{g, dir})
+		assert.Nil(t, os.WriteFile(ws.Path("out/config.json"), []byte(`{"port": 8080}`), 0o644))
+		ws.File("out/config.json").JSONEqual(`{"port": 9090}`)
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{`path does not exist:
+   path: "out/missing.txt"
+ expect: to exist
+  error: stat out/missing.txt: no such file or directory`})
+		assert.Workspace(tempDirT{g, dir}).File("out/missing.txt").Exists()
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{"test handler *mock.MockT does not provide a TempDir method"})
+		assert.Workspace(g)
+	})
+}
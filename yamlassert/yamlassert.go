@@ -0,0 +1,43 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package yamlassert offers the YAML codec and snapshot serializer of the
+// assertions of package assert, kept apart from it so that package check,
+// which serves non-test code, does not depend on a YAML library:
+//
+//	assert.ThatFile(t, "config.yaml").DecodedEqual(yamlassert.Codec, "port: 8080")
+//	assert.RoundTrips(t, cfg, yamlassert.Codec)
+//	assert.MatchSnapshotWith(t, cfg, yamlassert.Serializer)
+package yamlassert
+
+import (
+	"github.com/lvan100/go-assert"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	// Codec marshals values to YAML and back.
+	Codec = assert.NewCodec("yaml", yaml.Marshal, yaml.Unmarshal)
+
+	// Serializer renders values as YAML.
+	Serializer assert.Serializer = assert.SerializerFunc(func(v interface{}) (string, error) {
+		b, err := yaml.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+		return string(b), nil
+	})
+)
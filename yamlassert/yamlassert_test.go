@@ -0,0 +1,69 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package yamlassert_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/internal/mock"
+	"github.com/lvan100/go-assert/yamlassert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestMain(m *testing.M) {
+	assert.Configure(func(s *assert.Settings) {
+		s.Color = assert.ColorNever
+	})
+	os.Exit(m.Run())
+}
+
+func runCase(t *testing.T, f func(g *mock.MockT)) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	g := mock.NewMockT(ctrl)
+	g.EXPECT().Helper().AnyTimes()
+	f(g)
+}
+
+type Config struct {
+	Debug bool `yaml:"debug"`
+	Port  int  `yaml:"port"`
+}
+
+func TestCodec(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "config.yaml")
+	assert.Nil(t, os.WriteFile(file, []byte("port: 8080\ndebug: true\n"), 0o644))
+
+	runCase(t, func(g *mock.MockT) {
+		assert.ThatFile(g, file).DecodedEqual(yamlassert.Codec, "debug: true\nport: 8080")
+		assert.RoundTrips(g, Config{Debug: true, Port: 8080}, yamlassert.Codec)
+	})
+
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error(gomock.Any())
+		assert.ThatFile(g, file).DecodedEqual(yamlassert.Codec, "port: 80")
+	})
+}
+
+func TestSerializer(t *testing.T) {
+	s, err := yamlassert.Serializer.Serialize(Config{Port: 8080})
+	assert.Nil(t, err)
+	assert.ThatString(t, s).Equal("debug: false\nport: 8080\n")
+}
@@ -0,0 +1,14 @@
+module github.com/lvan100/go-assert/zstdassert
+
+go 1.24
+
+require (
+	github.com/klauspost/compress v1.18.0
+	github.com/lvan100/go-assert v1.0.0
+	go.uber.org/mock v0.5.1
+)
+
+require (
+	github.com/google/go-cmp v0.7.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
@@ -0,0 +1,43 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package zstdassert registers the zstd compression format with package
+// assert, so that its Decompressed assertions decode zstd content too. It
+// is a separate module to keep the zstd decoder out of the dependencies of
+// package assert. Import it for its side effect:
+//
+//	import _ "github.com/lvan100/go-assert/zstdassert"
+package zstdassert
+
+import (
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/lvan100/go-assert"
+)
+
+// magic is the magic number of zstd frames.
+const magic = "\x28\xb5\x2f\xfd"
+
+func init() {
+	assert.RegisterDecompressor("zstd", []byte(magic), newReader)
+}
+
+// newReader returns a decoder of the zstd stream r. It decodes on the
+// calling goroutine, so that no goroutine outlives the assertion.
+func newReader(r io.Reader) (io.Reader, error) {
+	return zstd.NewReader(r, zstd.WithDecoderConcurrency(1))
+}
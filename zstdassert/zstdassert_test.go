@@ -0,0 +1,70 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package zstdassert_test
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/lvan100/go-assert"
+	"github.com/lvan100/go-assert/internal/mock"
+	_ "github.com/lvan100/go-assert/zstdassert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestMain(m *testing.M) {
+	assert.Configure(func(s *assert.Settings) {
+		s.Color = assert.ColorNever
+	})
+	os.Exit(m.Run())
+}
+
+func runCase(t *testing.T, f func(g *mock.MockT)) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	g := mock.NewMockT(ctrl)
+	g.EXPECT().Helper().AnyTimes()
+	f(g)
+}
+
+func compressed(s string) []byte {
+	w, _ := zstd.NewWriter(nil)
+	defer w.Close()
+	return w.EncodeAll([]byte(s), nil)
+}
+
+func TestDecompressed(t *testing.T) {
+	b := compressed("hello, world!")
+	runCase(t, func(g *mock.MockT) {
+		assert.ThatBytes(g, b).Decompressed().Equal([]byte("hello, world!"))
+		assert.ThatReader(g, bytes.NewReader(b)).Decompressed().ContentEqual("hello, world!")
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error([]interface{}{fmt.Sprintf(`length mismatch:
+    got: length 13
+ expect: length 5
+compressed: zstd, %d bytes`, len(b))})
+		assert.ThatBytes(g, b).Decompressed().HasLen(5)
+	})
+	runCase(t, func(g *mock.MockT) {
+		g.EXPECT().Error(gomock.Any())
+		assert.ThatBytes(g, []byte(string(b[:4])+"garbage")).Decompressed().Equal(nil)
+	})
+}